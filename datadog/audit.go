@@ -0,0 +1,82 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// auditEventMetadataEnvVars maps environment variables carrying Terraform run
+// metadata to the tag they are reported under on audit events.
+var auditEventMetadataEnvVars = map[string]string{
+	"TF_WORKSPACE": "workspace",
+	"TFC_RUN_ID":   "run_id",
+	"USER":         "actor",
+}
+
+// auditEvent posts a Datadog event describing a provider-driven change,
+// giving an in-Datadog audit trail of infrastructure-as-code changes. The
+// change itself already succeeded, so a failure to post the event only
+// produces a warning. The pinned API client does not expose event creation,
+// so the event is posted through a raw request.
+func (p *ProviderConfiguration) auditEvent(action, resourceType, id string) {
+	if !p.auditEventsEnabled {
+		return
+	}
+	tags := []string{
+		"source:terraform",
+		"action:" + action,
+		"resource_type:" + resourceType,
+		"resource_id:" + id,
+	}
+	for envVar, tag := range auditEventMetadataEnvVars {
+		if v := os.Getenv(envVar); v != "" {
+			tags = append(tags, tag+":"+v)
+		}
+	}
+	event := map[string]interface{}{
+		"title":            fmt.Sprintf("Terraform %s of %s", action, resourceType),
+		"text":             fmt.Sprintf("Terraform performed a %s of %s %s.", action, resourceType, id),
+		"tags":             tags,
+		"source_type_name": "terraform",
+	}
+	if _, err := sendRequest(p, http.MethodPost, "/api/v1/events", event, nil); err != nil {
+		p.appendWarning(resourceType, "failed to post audit event: %v", err)
+	}
+}
+
+// withAuditEvents wraps the create, update and delete functions of every
+// resource so a successful change posts an audit event when the feature is
+// enabled on the provider.
+func withAuditEvents(resources map[string]*schema.Resource) {
+	for resourceType, resource := range resources {
+		resourceType := resourceType
+		if create := resource.Create; create != nil {
+			resource.Create = auditedChange(resourceType, "create", create)
+		}
+		if update := resource.Update; update != nil {
+			resource.Update = auditedChange(resourceType, "update", update)
+		}
+		if delete := resource.Delete; delete != nil {
+			resource.Delete = auditedChange(resourceType, "delete", delete)
+		}
+	}
+}
+
+func auditedChange(resourceType, action string, f func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+	return func(d *schema.ResourceData, meta interface{}) error {
+		id := d.Id()
+		if err := f(d, meta); err != nil {
+			return err
+		}
+		if d.Id() != "" {
+			id = d.Id()
+		}
+		if providerConf, ok := meta.(*ProviderConfiguration); ok {
+			providerConf.auditEvent(action, resourceType, id)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,79 @@
+package datadog
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+type countingTransport struct {
+	calls      int
+	statusCode int
+}
+
+func (t *countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.calls++
+	return &http.Response{
+		Status:     http.StatusText(t.statusCode),
+		StatusCode: t.statusCode,
+		Header:     http.Header{},
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte("body"))),
+		Request:    req,
+	}, nil
+}
+
+func doRequest(t *testing.T, transport http.RoundTripper, method string) string {
+	t.Helper()
+	req, err := http.NewRequest(method, "https://api.datadoghq.com/api/v1/dashboard/abc-def-ghi", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body)
+}
+
+func TestCachingTransportMemoizesGets(t *testing.T) {
+	upstream := &countingTransport{statusCode: http.StatusOK}
+	transport := newCachingTransport(upstream)
+
+	for i := 0; i < 3; i++ {
+		if body := doRequest(t, transport, http.MethodGet); body != "body" {
+			t.Fatalf("unexpected body %q", body)
+		}
+	}
+	if upstream.calls != 1 {
+		t.Errorf("expected 1 upstream call, got %d", upstream.calls)
+	}
+}
+
+func TestCachingTransportWritesEmptyTheCache(t *testing.T) {
+	upstream := &countingTransport{statusCode: http.StatusOK}
+	transport := newCachingTransport(upstream)
+
+	doRequest(t, transport, http.MethodGet)
+	doRequest(t, transport, http.MethodPut)
+	doRequest(t, transport, http.MethodGet)
+	if upstream.calls != 3 {
+		t.Errorf("expected 3 upstream calls, got %d", upstream.calls)
+	}
+}
+
+func TestCachingTransportSkipsErrors(t *testing.T) {
+	upstream := &countingTransport{statusCode: http.StatusNotFound}
+	transport := newCachingTransport(upstream)
+
+	doRequest(t, transport, http.MethodGet)
+	doRequest(t, transport, http.MethodGet)
+	if upstream.calls != 2 {
+		t.Errorf("expected 2 upstream calls, got %d", upstream.calls)
+	}
+}
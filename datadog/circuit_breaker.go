@@ -0,0 +1,66 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// circuitBreakerCooldown is how long a tripped breaker fails fast before
+// letting a probe request through again.
+const circuitBreakerCooldown = 30 * time.Second
+
+// circuitBreakerTransport fails fast once the API has returned too many
+// consecutive 5xx responses. During a Datadog outage every call of a large
+// apply would otherwise be retried until it times out, which takes long and
+// buries the real problem; the breaker surfaces one clear diagnostic instead.
+// After a cooldown a single request is let through to probe whether the API
+// has recovered.
+type circuitBreakerTransport struct {
+	wrapped   http.RoundTripper
+	threshold int
+
+	mu             sync.Mutex
+	consecutive5xx int
+	openedAt       time.Time
+}
+
+func newCircuitBreakerTransport(wrapped http.RoundTripper, threshold int) *circuitBreakerTransport {
+	return &circuitBreakerTransport{
+		wrapped:   wrapped,
+		threshold: threshold,
+	}
+}
+
+func (t *circuitBreakerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	if t.consecutive5xx >= t.threshold {
+		if time.Since(t.openedAt) < circuitBreakerCooldown {
+			t.mu.Unlock()
+			return nil, fmt.Errorf("Datadog API unavailable: %d consecutive requests returned a server error, not calling %s %s; the next call is attempted after %s", t.consecutive5xx, req.Method, req.URL.Path, circuitBreakerCooldown)
+		}
+		// Cooldown over: let this request probe the API. The window is
+		// re-armed so concurrent requests keep failing fast until the probe
+		// succeeds.
+		t.openedAt = time.Now()
+	}
+	t.mu.Unlock()
+
+	resp, err := t.wrapped.RoundTrip(req)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	switch {
+	case err != nil:
+		// Transport errors say nothing about the API health.
+	case resp.StatusCode >= 500:
+		t.consecutive5xx++
+		if t.consecutive5xx == t.threshold {
+			t.openedAt = time.Now()
+		}
+	default:
+		t.consecutive5xx = 0
+	}
+	return resp, err
+}
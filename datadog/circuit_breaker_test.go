@@ -0,0 +1,50 @@
+package datadog
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestCircuitBreakerTripsAfterThreshold(t *testing.T) {
+	upstream := &countingTransport{statusCode: http.StatusServiceUnavailable}
+	transport := newCircuitBreakerTransport(upstream, 3)
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.datadoghq.com/api/v1/validate", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := transport.RoundTrip(req); err != nil {
+			t.Fatalf("request %d should have reached the API: %v", i, err)
+		}
+	}
+	_, err = transport.RoundTrip(req)
+	if err == nil || !strings.Contains(err.Error(), "Datadog API unavailable") {
+		t.Fatalf("expected a Datadog API unavailable error, got %v", err)
+	}
+	if upstream.calls != 3 {
+		t.Errorf("expected 3 upstream calls, got %d", upstream.calls)
+	}
+}
+
+func TestCircuitBreakerResetsOnSuccess(t *testing.T) {
+	upstream := &countingTransport{statusCode: http.StatusServiceUnavailable}
+	transport := newCircuitBreakerTransport(upstream, 3)
+
+	req, err := http.NewRequest(http.MethodGet, "https://api.datadoghq.com/api/v1/validate", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transport.RoundTrip(req)
+	transport.RoundTrip(req)
+	upstream.statusCode = http.StatusOK
+	transport.RoundTrip(req)
+	upstream.statusCode = http.StatusServiceUnavailable
+	if _, err := transport.RoundTrip(req); err != nil {
+		t.Fatalf("breaker should have been reset by the successful response: %v", err)
+	}
+	if upstream.calls != 4 {
+		t.Errorf("expected 4 upstream calls, got %d", upstream.calls)
+	}
+}
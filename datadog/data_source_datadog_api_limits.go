@@ -0,0 +1,109 @@
+package datadog
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// The API does not expose a single endpoint listing rate limit
+// configuration; limits are reported per endpoint family through
+// X-RateLimit-* response headers. The data source probes one cheap GET per
+// family and collects those headers.
+var apiLimitProbeEndpoints = map[string]string{
+	"dashboards": "/api/v1/dashboard",
+	"downtimes":  "/api/v1/downtime",
+	"monitors":   "/api/v1/monitor?name=terraform-provider-rate-limit-probe",
+	"slos":       "/api/v1/slo?limit=1",
+	"synthetics": "/api/v1/synthetics/tests",
+}
+
+func dataSourceDatadogApiLimits() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to retrieve the org's current API rate limit configuration per endpoint family, so runbooks and parallelism settings can adapt to the org tier.",
+		Read:        dataSourceDatadogApiLimitsRead,
+
+		Schema: map[string]*schema.Schema{
+			// Computed values
+			"limits": {
+				Description: "Rate limits per endpoint family.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"endpoint_family": {
+							Description: "Endpoint family the limit applies to.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"limit": {
+							Description: "Number of requests allowed per period.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"remaining": {
+							Description: "Number of requests remaining in the current period.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"period": {
+							Description: "Length of the period in seconds.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"reset": {
+							Description: "Seconds until the current period resets.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func rateLimitHeader(httpresp *http.Response, name string) int {
+	v, err := strconv.Atoi(httpresp.Header.Get(name))
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+func dataSourceDatadogApiLimitsRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	families := make([]string, 0, len(apiLimitProbeEndpoints))
+	for family := range apiLimitProbeEndpoints {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+
+	limits := []map[string]interface{}{}
+	for _, family := range families {
+		httpresp, err := sendRequest(providerConf, http.MethodGet, apiLimitProbeEndpoints[family], nil, nil)
+		if err != nil && httpresp == nil {
+			return translateClientError(err, "error probing API rate limits")
+		}
+		// Rate limit headers are present even on error statuses.
+		if httpresp.Header.Get("X-RateLimit-Limit") == "" {
+			continue
+		}
+		limits = append(limits, map[string]interface{}{
+			"endpoint_family": family,
+			"limit":           rateLimitHeader(httpresp, "X-RateLimit-Limit"),
+			"remaining":       rateLimitHeader(httpresp, "X-RateLimit-Remaining"),
+			"period":          rateLimitHeader(httpresp, "X-RateLimit-Period"),
+			"reset":           rateLimitHeader(httpresp, "X-RateLimit-Reset"),
+		})
+	}
+	if err := d.Set("limits", limits); err != nil {
+		return err
+	}
+	d.SetId("datadog-api-limits")
+
+	return nil
+}
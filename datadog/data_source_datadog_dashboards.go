@@ -0,0 +1,89 @@
+package datadog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDatadogDashboards() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list existing dashboards, optionally filtered by title, so cleanup and inventory tooling can enumerate all boards.",
+		Read:        dataSourceDatadogDashboardsRead,
+
+		Schema: map[string]*schema.Schema{
+			"title_filter": {
+				Description: "Only dashboards whose title contains this string, case insensitively, are returned.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			// Computed values
+			"dashboards": {
+				Description: "The list of matching dashboards.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the dashboard.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"title": {
+							Description: "The title of the dashboard.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"layout_type": {
+							Description: "The layout type of the dashboard.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"url": {
+							Description: "The URL of the dashboard.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_read_only": {
+							Description: "Whether the dashboard is read only.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatadogDashboardsRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	dashResponse, _, err := datadogClientV1.DashboardsApi.ListDashboards(authV1).Execute()
+	if err != nil {
+		return translateClientError(err, "error querying dashboards")
+	}
+
+	titleFilter := strings.ToLower(d.Get("title_filter").(string))
+	dashboards := []map[string]interface{}{}
+	for _, dash := range dashResponse.GetDashboards() {
+		if titleFilter != "" && !strings.Contains(strings.ToLower(dash.GetTitle()), titleFilter) {
+			continue
+		}
+		dashboards = append(dashboards, map[string]interface{}{
+			"id":           dash.GetId(),
+			"title":        dash.GetTitle(),
+			"layout_type":  dash.GetLayoutType(),
+			"url":          dash.GetUrl(),
+			"is_read_only": dash.GetIsReadOnly(),
+		})
+	}
+
+	d.SetId(fmt.Sprintf("datadog-dashboards-%s", titleFilter))
+	d.Set("dashboards", dashboards)
+
+	return nil
+}
@@ -0,0 +1,111 @@
+package datadog
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDatadogDowntimeMatches() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to preview which monitors a downtime scope would mute, enabling plan-time policy checks that maintenance windows don't silence unrelated alerts. The API applies downtime scopes to monitor groups at evaluation time, so this preview approximates the match from the monitor definitions: a scope element matches a monitor when it appears in its tags or its query.",
+		Read:        dataSourceDatadogDowntimeMatchesRead,
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Description: "The scope of the downtime to preview, e.g. `[\"env:prod\"]`. A scope of `[\"*\"]` matches every monitor.",
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"monitor_tags": {
+				Description: "A list of monitor tags the downtime is limited to, matching the `monitor_tags` argument of `datadog_downtime`.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			// Computed values
+			"monitors": {
+				Description: "The monitors the downtime scope would mute.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the monitor.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the monitor.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// monitorMatchesDowntimeScope approximates the downtime scope matching the
+// API performs against monitor groups at evaluation time: every scope element
+// has to appear in the monitor tags or in its query.
+func monitorMatchesDowntimeScope(scope []string, tags []string, query string) bool {
+	for _, s := range scope {
+		if s == "*" {
+			continue
+		}
+		matched := strings.Contains(query, s)
+		for _, tag := range tags {
+			if tag == s {
+				matched = true
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+func dataSourceDatadogDowntimeMatchesRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	scope := []string{}
+	for _, s := range d.Get("scope").([]interface{}) {
+		scope = append(scope, s.(string))
+	}
+	monitorTags := []string{}
+	for _, t := range d.Get("monitor_tags").([]interface{}) {
+		monitorTags = append(monitorTags, t.(string))
+	}
+
+	req := datadogClientV1.MonitorsApi.ListMonitors(authV1)
+	if len(monitorTags) > 0 {
+		req = req.MonitorTags(strings.Join(monitorTags, ","))
+	}
+	monitors, _, err := req.Execute()
+	if err != nil {
+		return translateClientError(err, "error listing monitors")
+	}
+
+	matches := []map[string]interface{}{}
+	for _, monitor := range monitors {
+		if monitorMatchesDowntimeScope(scope, monitor.GetTags(), monitor.GetQuery()) {
+			matches = append(matches, map[string]interface{}{
+				"id":   monitor.GetId(),
+				"name": monitor.GetName(),
+			})
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(scope, ",")+"|"+strings.Join(monitorTags, ",")))))
+	d.Set("monitors", matches)
+
+	return nil
+}
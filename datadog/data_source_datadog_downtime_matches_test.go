@@ -0,0 +1,26 @@
+package datadog
+
+import "testing"
+
+func TestMonitorMatchesDowntimeScope(t *testing.T) {
+	cases := []struct {
+		name    string
+		scope   []string
+		tags    []string
+		query   string
+		matches bool
+	}{
+		{"wildcard matches everything", []string{"*"}, nil, "avg(last_5m):avg:system.cpu.user{*} > 90", true},
+		{"scope in tags", []string{"env:prod"}, []string{"env:prod", "team:sre"}, "avg(last_5m):avg:system.cpu.user{*} > 90", true},
+		{"scope in query", []string{"env:prod"}, nil, "avg(last_5m):avg:system.cpu.user{env:prod} > 90", true},
+		{"scope absent", []string{"env:prod"}, []string{"env:staging"}, "avg(last_5m):avg:system.cpu.user{env:staging} > 90", false},
+		{"all elements must match", []string{"env:prod", "team:sre"}, []string{"env:prod"}, "avg(last_5m):avg:system.cpu.user{env:prod} > 90", false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := monitorMatchesDowntimeScope(tc.scope, tc.tags, tc.query); got != tc.matches {
+				t.Errorf("monitorMatchesDowntimeScope(%v, %v, %q) = %v, want %v", tc.scope, tc.tags, tc.query, got, tc.matches)
+			}
+		})
+	}
+}
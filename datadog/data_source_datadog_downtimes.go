@@ -0,0 +1,148 @@
+package datadog
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDatadogDowntimes() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list currently active downtimes, optionally filtered by scope or monitor tags. This lets deploy pipelines check whether alerts are muted before proceeding.",
+		Read:        dataSourceDatadogDowntimesRead,
+
+		Schema: map[string]*schema.Schema{
+			"scope": {
+				Description: "Only return downtimes whose scope contains this entry, e.g. `env:prod`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"monitor_tags": {
+				Description: "Only return downtimes whose monitor tags contain all of these tags. A downtime with monitor tag `*` matches any filter.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			// Computed values
+			"downtimes": {
+				Description: "List of active downtimes matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the downtime.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"scope": {
+							Description: "Scope of the downtime.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"monitor_id": {
+							Description: "ID of the monitor silenced by the downtime, if it applies to a single monitor.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"monitor_tags": {
+							Description: "Monitor tags the downtime applies to.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"message": {
+							Description: "Message of the downtime.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"start": {
+							Description: "POSIX timestamp the downtime starts at.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"end": {
+							Description: "POSIX timestamp the downtime ends at, 0 if it never ends.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func downtimeMatchesScope(downtimeScope []string, scope string) bool {
+	if scope == "" {
+		return true
+	}
+	for _, s := range downtimeScope {
+		if s == scope || s == "*" {
+			return true
+		}
+	}
+	return false
+}
+
+func downtimeMatchesMonitorTags(downtimeTags []string, tags []interface{}) bool {
+	for _, t := range tags {
+		found := false
+		for _, downtimeTag := range downtimeTags {
+			if downtimeTag == t.(string) || downtimeTag == "*" {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func dataSourceDatadogDowntimesRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	downtimes, _, err := datadogClientV1.DowntimesApi.ListDowntimes(authV1).CurrentOnly(true).Execute()
+	if err != nil {
+		return translateClientError(err, "error listing downtimes")
+	}
+	scope := d.Get("scope").(string)
+	monitorTags := d.Get("monitor_tags").([]interface{})
+	tfDowntimes := []map[string]interface{}{}
+	for _, downtime := range downtimes {
+		if !downtime.GetActive() {
+			continue
+		}
+		if !downtimeMatchesScope(downtime.GetScope(), scope) {
+			continue
+		}
+		if !downtimeMatchesMonitorTags(downtime.GetMonitorTags(), monitorTags) {
+			continue
+		}
+		tfDowntimes = append(tfDowntimes, map[string]interface{}{
+			"id":           downtime.GetId(),
+			"scope":        downtime.GetScope(),
+			"monitor_id":   downtime.GetMonitorId(),
+			"monitor_tags": downtime.GetMonitorTags(),
+			"message":      downtime.GetMessage(),
+			"start":        downtime.GetStart(),
+			"end":          downtime.GetEnd(),
+		})
+	}
+	if err := d.Set("downtimes", tfDowntimes); err != nil {
+		return err
+	}
+	tags := make([]string, len(monitorTags))
+	for i, t := range monitorTags {
+		tags[i] = t.(string)
+	}
+	d.SetId(fmt.Sprintf("datadog-downtimes-%s-%s", scope, strings.Join(tags, ",")))
+
+	return nil
+}
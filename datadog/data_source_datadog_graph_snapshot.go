@@ -0,0 +1,93 @@
+package datadog
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func dataSourceDatadogGraphSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to generate a graph snapshot URL for a query and timeframe, so runbooks and docs can embed fresh snapshots generated during CI.",
+		Read:        dataSourceDatadogGraphSnapshotRead,
+		Schema: map[string]*schema.Schema{
+			"metric_query": {
+				Description: "The metric query of the graph, e.g. `avg:system.cpu.user{*}`. Exactly one of `metric_query` and `graph_def` must be set.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"graph_def": {
+				Description:  "A JSON document defining the graph, as found in a dashboard widget definition. Takes precedence over `metric_query`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"event_query": {
+				Description: "A query to overlay events on the graph. Only used with `metric_query`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"title": {
+				Description: "The title of the graph.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"start": {
+				Description: "The start of the queried timeframe, as a POSIX timestamp.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+			"end": {
+				Description: "The end of the queried timeframe, as a POSIX timestamp.",
+				Type:        schema.TypeInt,
+				Required:    true,
+			},
+
+			// Computed values
+			"snapshot_url": {
+				Description: "The URL of the generated snapshot.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceDatadogGraphSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	metricQuery := d.Get("metric_query").(string)
+	graphDef := d.Get("graph_def").(string)
+	if (metricQuery == "") == (graphDef == "") {
+		return fmt.Errorf("exactly one of metric_query and graph_def must be set")
+	}
+
+	request := datadogClientV1.SnapshotsApi.GetGraphSnapshot(authV1).
+		Start(int64(d.Get("start").(int))).
+		End(int64(d.Get("end").(int)))
+	if graphDef != "" {
+		request = request.GraphDef(graphDef)
+	} else {
+		request = request.MetricQuery(metricQuery)
+		if eventQuery, ok := d.GetOk("event_query"); ok {
+			request = request.EventQuery(eventQuery.(string))
+		}
+	}
+	if title, ok := d.GetOk("title"); ok {
+		request = request.Title(title.(string))
+	}
+
+	snapshot, _, err := request.Execute()
+	if err != nil {
+		return translateClientError(err, "error generating graph snapshot")
+	}
+
+	d.SetId(fmt.Sprintf("%x", sha256.Sum256([]byte(fmt.Sprintf("%s|%s|%d|%d", metricQuery, graphDef, d.Get("start").(int), d.Get("end").(int))))))
+	d.Set("snapshot_url", snapshot.GetSnapshotUrl())
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package datadog
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDatadogLogsPipelines() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list all existing logs pipelines, including integration pipelines, for use in other resources such as the logs pipeline order.",
+		Read:        dataSourceDatadogLogsPipelinesRead,
+
+		Schema: map[string]*schema.Schema{
+			// Computed values
+			"logs_pipelines": {
+				Description: "List of logs pipelines, in their current processing order.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the pipeline.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the pipeline.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"is_read_only": {
+							Description: "Whether the pipeline is read-only (integration pipelines are).",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"is_enabled": {
+							Description: "Whether the pipeline is enabled.",
+							Type:        schema.TypeBool,
+							Computed:    true,
+						},
+						"filter_query": {
+							Description: "Filter query of the pipeline.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatadogLogsPipelinesRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	pipelines, _, err := datadogClientV1.LogsPipelinesApi.ListLogsPipelines(authV1).Execute()
+	if err != nil {
+		return translateClientError(err, "error listing logs pipelines")
+	}
+	tfPipelines := make([]map[string]interface{}, 0, len(pipelines))
+	for _, pipeline := range pipelines {
+		tfPipelines = append(tfPipelines, map[string]interface{}{
+			"id":           pipeline.GetId(),
+			"name":         pipeline.GetName(),
+			"is_read_only": pipeline.GetIsReadOnly(),
+			"is_enabled":   pipeline.GetIsEnabled(),
+			"filter_query": pipeline.Filter.GetQuery(),
+		})
+	}
+	if err := d.Set("logs_pipelines", tfPipelines); err != nil {
+		return err
+	}
+	d.SetId("datadog-logs-pipelines")
+
+	return nil
+}
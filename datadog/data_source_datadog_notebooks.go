@@ -0,0 +1,113 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDatadogNotebooks() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list notebooks, optionally filtered by author or name, for linking from dashboards' note widgets and for cleanup automation.",
+		Read:        dataSourceDatadogNotebooksRead,
+
+		Schema: map[string]*schema.Schema{
+			"author_handle": {
+				Description: "Only return notebooks created by this author handle.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"query": {
+				Description: "Only return notebooks whose name matches this query.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			// Computed values
+			"notebooks": {
+				Description: "List of notebooks matching the filters.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "ID of the notebook.",
+							Type:        schema.TypeInt,
+							Computed:    true,
+						},
+						"name": {
+							Description: "Name of the notebook.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"author_handle": {
+							Description: "Handle of the notebook's author.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"status": {
+							Description: "Publication status of the notebook.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"modified": {
+							Description: "Timestamp of the last modification.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatadogNotebooksRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	params := url.Values{}
+	if v, ok := d.GetOk("author_handle"); ok {
+		params.Set("author_handle", v.(string))
+	}
+	if v, ok := d.GetOk("query"); ok {
+		params.Set("query", v.(string))
+	}
+	// The notebooks endpoints are not covered by the pinned API client, so
+	// the list is fetched directly.
+	path := "/api/v1/notebooks"
+	if len(params) > 0 {
+		path += "?" + params.Encode()
+	}
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, path, nil, &response); err != nil {
+		return translateClientError(err, "error listing notebooks")
+	}
+	notebooks := []map[string]interface{}{}
+	if data, ok := response["data"].([]interface{}); ok {
+		for _, n := range data {
+			notebook, _ := n.(map[string]interface{})
+			attributes, _ := notebook["attributes"].(map[string]interface{})
+			author, _ := attributes["author"].(map[string]interface{})
+			id, _ := notebook["id"].(float64)
+			authorHandle, _ := author["handle"].(string)
+			name, _ := attributes["name"].(string)
+			status, _ := attributes["status"].(string)
+			modified, _ := attributes["modified"].(string)
+			notebooks = append(notebooks, map[string]interface{}{
+				"id":            int(id),
+				"name":          name,
+				"author_handle": authorHandle,
+				"status":        status,
+				"modified":      modified,
+			})
+		}
+	}
+	if err := d.Set("notebooks", notebooks); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("datadog-notebooks-%s-%s", d.Get("author_handle").(string), d.Get("query").(string)))
+
+	return nil
+}
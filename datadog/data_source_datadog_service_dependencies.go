@@ -0,0 +1,90 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDatadogServiceDependencies() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to retrieve the upstream and downstream dependencies of an APM service, so graph-aware alerting modules can enumerate them.",
+		Read:        dataSourceDatadogServiceDependenciesRead,
+
+		Schema: map[string]*schema.Schema{
+			"service": {
+				Description: "Name of the APM service.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"env": {
+				Description: "APM environment the dependency map is read from.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			// Computed values
+			"downstream": {
+				Description: "Services called by the service, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"upstream": {
+				Description: "Services calling the service, sorted by name.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceDatadogServiceDependenciesRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	service := d.Get("service").(string)
+	env := d.Get("env").(string)
+
+	// The service dependencies endpoint, which the pinned API client does not
+	// cover, returns the whole dependency map of the environment; both
+	// directions are derived from it locally.
+	var response map[string]interface{}
+	path := "/api/v1/service_dependencies?env=" + url.QueryEscape(env)
+	if _, err := sendRequest(providerConf, http.MethodGet, path, nil, &response); err != nil {
+		return translateClientError(err, "error getting service dependencies")
+	}
+
+	downstream := []string{}
+	upstream := []string{}
+	for name, e := range response {
+		entry, _ := e.(map[string]interface{})
+		calls, _ := entry["calls"].([]interface{})
+		for _, c := range calls {
+			called, ok := c.(string)
+			if !ok {
+				continue
+			}
+			if name == service {
+				downstream = append(downstream, called)
+			}
+			if called == service {
+				upstream = append(upstream, name)
+			}
+		}
+	}
+	sort.Strings(downstream)
+	sort.Strings(upstream)
+
+	if err := d.Set("downstream", downstream); err != nil {
+		return err
+	}
+	if err := d.Set("upstream", upstream); err != nil {
+		return err
+	}
+	d.SetId(fmt.Sprintf("datadog-service-dependencies-%s-%s", env, service))
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package datadog
+
+import (
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func dataSourceDatadogSyntheticsPrivateLocations() *schema.Resource {
+	return &schema.Resource{
+		Description: "Use this data source to list the Synthetics private locations of the organization, so tests can reference locations created by another team's stack.",
+		Read:        dataSourceDatadogSyntheticsPrivateLocationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"private_locations": {
+				Description: "The list of private locations.",
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Description: "The ID of the private location.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"name": {
+							Description: "The name of the private location.",
+							Type:        schema.TypeString,
+							Computed:    true,
+						},
+						"tags": {
+							Description: "The tags of the private location.",
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceDatadogSyntheticsPrivateLocationsRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	syntheticsLocations, _, err := datadogClientV1.SyntheticsApi.ListLocations(authV1).Execute()
+	if err != nil {
+		return translateClientError(err, "error listing synthetics locations")
+	}
+
+	privateLocations := []map[string]interface{}{}
+	for _, location := range syntheticsLocations.GetLocations() {
+		// Private location IDs are prefixed with "pl:"; the managed
+		// locations are skipped.
+		if !strings.HasPrefix(location.GetId(), "pl:") {
+			continue
+		}
+		privateLocation, _, err := datadogClientV1.SyntheticsApi.GetPrivateLocation(authV1, location.GetId()).Execute()
+		if err != nil {
+			return translateClientError(err, "error getting synthetics private location")
+		}
+		privateLocations = append(privateLocations, map[string]interface{}{
+			"id":   privateLocation.GetId(),
+			"name": privateLocation.GetName(),
+			"tags": privateLocation.GetTags(),
+		})
+	}
+
+	d.SetId("datadog-synthetics-private-locations")
+	d.Set("private_locations", privateLocations)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"sync"
+)
+
+// The provider is built against terraform-plugin-sdk v1, whose CRUD functions
+// can only return a fatal error; there is no channel yet for the warning
+// diagnostics of SDK v2. Non-fatal conditions (a dashboard list update
+// failing, a deprecated field being used, an API deprecation header, ...)
+// would otherwise be buried in DEBUG logs, so they are collected here and
+// logged at WARN level, which Terraform surfaces with the default TF_LOG
+// settings. Once the provider migrates to the Diagnostics-returning CRUD
+// signatures, appendDiagnostic becomes the single place to drain into
+// diag.Diagnostics.
+
+type diagnostic struct {
+	resource string
+	summary  string
+}
+
+type diagnostics struct {
+	mu       sync.Mutex
+	warnings []diagnostic
+}
+
+// appendWarning records a non-fatal condition hit while applying the given
+// resource and logs it at WARN level.
+func (p *ProviderConfiguration) appendWarning(resource, format string, args ...interface{}) {
+	summary := fmt.Sprintf(format, args...)
+	log.Printf("[WARN] %s: %s", resource, summary)
+	p.diagnostics.mu.Lock()
+	defer p.diagnostics.mu.Unlock()
+	p.diagnostics.warnings = append(p.diagnostics.warnings, diagnostic{resource: resource, summary: summary})
+}
+
+// Warnings returns a copy of the non-fatal conditions recorded so far.
+func (p *ProviderConfiguration) Warnings() []diagnostic {
+	p.diagnostics.mu.Lock()
+	defer p.diagnostics.mu.Unlock()
+	warnings := make([]diagnostic, len(p.diagnostics.warnings))
+	copy(warnings, p.diagnostics.warnings)
+	return warnings
+}
@@ -0,0 +1,119 @@
+// Package widgets hosts the shared dashboard widget converters between
+// Terraform definition maps and the widget definition JSON sent to the API.
+// The per-widget helpers in the provider each repeat the same field-by-field
+// copying; a FieldMapping describes a widget field once and the generic
+// converters derive both directions from it. Keeping the converters in their
+// own package lets new resources (and external tooling) reuse them instead of
+// copy-pasting. Widgets are migrated to mappings incrementally.
+package widgets
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// FieldMapping maps one Terraform schema key to the corresponding key in the
+// widget definition JSON sent to the API.
+type FieldMapping struct {
+	// TerraformKey is the key in the Terraform definition map.
+	TerraformKey string
+	// JSONKey is the key in the Datadog JSON definition. Empty means the
+	// same as TerraformKey.
+	JSONKey string
+	// Required marks fields that are always sent, even when zero valued.
+	Required bool
+	// Build transforms the Terraform value before it is sent. Optional.
+	Build func(v interface{}) interface{}
+	// Flatten transforms the Datadog value before it is stored in state.
+	// Optional.
+	Flatten func(v interface{}) interface{}
+}
+
+func (m *FieldMapping) datadogKey() string {
+	if m.JSONKey != "" {
+		return m.JSONKey
+	}
+	return m.TerraformKey
+}
+
+// valueIsZero reports whether an optional Terraform value should be omitted
+// from the JSON definition, mirroring the `len(v) != 0` guards of the
+// hand-written builders. Booleans are never zero: the hand-written builders
+// always send them when the key is present.
+func valueIsZero(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case string:
+		return value == ""
+	case bool:
+		return false
+	default:
+		rv := reflect.ValueOf(v)
+		if rv.Kind() == reflect.Slice || rv.Kind() == reflect.Map {
+			return rv.Len() == 0
+		}
+		return false
+	}
+}
+
+// BuildJSONDefinition converts a Terraform definition map to a widget
+// definition JSON map according to the mapping, tagging it with the widget
+// type.
+func BuildJSONDefinition(terraformDefinition map[string]interface{}, mapping []FieldMapping, widgetType string) map[string]interface{} {
+	jsonDefinition := map[string]interface{}{"type": widgetType}
+	for _, field := range mapping {
+		v, ok := terraformDefinition[field.TerraformKey]
+		if !ok || (!field.Required && valueIsZero(v)) {
+			continue
+		}
+		if field.Build != nil {
+			v = field.Build(v)
+		}
+		jsonDefinition[field.datadogKey()] = v
+	}
+	return jsonDefinition
+}
+
+// FlattenJSONDefinition converts a widget definition JSON map back to a
+// Terraform definition map according to the mapping.
+func FlattenJSONDefinition(jsonDefinition map[string]interface{}, mapping []FieldMapping) map[string]interface{} {
+	terraformDefinition := map[string]interface{}{}
+	for _, field := range mapping {
+		v, ok := jsonDefinition[field.datadogKey()]
+		if !ok {
+			continue
+		}
+		if field.Flatten != nil {
+			v = field.Flatten(v)
+		}
+		terraformDefinition[field.TerraformKey] = v
+	}
+	return terraformDefinition
+}
+
+// DecodeJSONDefinition unmarshals a widget definition JSON map into the typed
+// client model (out must be a pointer), so mapping-based widgets plug into
+// the existing typed build path.
+func DecodeJSONDefinition(jsonDefinition map[string]interface{}, out interface{}) error {
+	encoded, err := json.Marshal(jsonDefinition)
+	if err != nil {
+		return fmt.Errorf("failed to marshal widget definition: %s", err.Error())
+	}
+	return json.Unmarshal(encoded, out)
+}
+
+// EncodeJSONDefinition marshals a typed widget definition into a JSON map
+// for mapping-based flattening.
+func EncodeJSONDefinition(definition interface{}) (map[string]interface{}, error) {
+	encoded, err := json.Marshal(definition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal widget definition: %s", err.Error())
+	}
+	jsonDefinition := map[string]interface{}{}
+	if err := json.Unmarshal(encoded, &jsonDefinition); err != nil {
+		return nil, err
+	}
+	return jsonDefinition, nil
+}
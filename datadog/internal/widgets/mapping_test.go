@@ -0,0 +1,81 @@
+package widgets
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+)
+
+// roundTripNoteDefinition builds the typed note widget from a Terraform
+// definition map and flattens it back.
+func roundTripNoteDefinition(t *testing.T, terraformDefinition map[string]interface{}) map[string]interface{} {
+	t.Helper()
+	datadogDefinition, err := BuildNote(terraformDefinition)
+	if err != nil {
+		t.Fatalf("failed to build note widget definition: %s", err)
+	}
+	jsonDefinition, err := EncodeJSONDefinition(datadogDefinition)
+	if err != nil {
+		t.Fatalf("failed to encode note widget definition: %s", err)
+	}
+	if jsonDefinition["type"] != string(datadogV1.NOTEWIDGETDEFINITIONTYPE_NOTE) {
+		t.Errorf("expected widget type %q, got %v", datadogV1.NOTEWIDGETDEFINITIONTYPE_NOTE, jsonDefinition["type"])
+	}
+	result, err := FlattenNote(*datadogDefinition)
+	if err != nil {
+		t.Fatalf("failed to flatten note widget definition: %s", err)
+	}
+	return result
+}
+
+func TestNoteRoundTrip(t *testing.T) {
+	terraformDefinition := map[string]interface{}{
+		"content":          "note content",
+		"background_color": "pink",
+		"font_size":        "14",
+		"text_align":       "center",
+		"show_tick":        true,
+		"tick_pos":         "50%",
+		"tick_edge":        "left",
+	}
+	result := roundTripNoteDefinition(t, terraformDefinition)
+	if !reflect.DeepEqual(terraformDefinition, result) {
+		t.Errorf("round trip mismatch: expected %v, got %v", terraformDefinition, result)
+	}
+}
+
+// TestNoteRoundTripFuzz round-trips randomly populated note definitions;
+// optional zero values must be dropped, everything else must survive
+// unchanged.
+func TestNoteRoundTripFuzz(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	textAligns := []string{"", "left", "center", "right"}
+	tickEdges := []string{"", "bottom", "top", "left", "right"}
+	randomString := func(values ...string) string {
+		return values[rng.Intn(len(values))]
+	}
+	for i := 0; i < 100; i++ {
+		terraformDefinition := map[string]interface{}{
+			"content":          randomString("a note", "another note"),
+			"background_color": randomString("", "pink", "gray"),
+			"font_size":        randomString("", "14", "18"),
+			"text_align":       randomString(textAligns...),
+			"show_tick":        rng.Intn(2) == 0,
+			"tick_pos":         randomString("", "50%"),
+			"tick_edge":        randomString(tickEdges...),
+		}
+		expected := map[string]interface{}{}
+		for k, v := range terraformDefinition {
+			if s, ok := v.(string); ok && s == "" {
+				continue
+			}
+			expected[k] = v
+		}
+		result := roundTripNoteDefinition(t, terraformDefinition)
+		if !reflect.DeepEqual(expected, result) {
+			t.Errorf("round trip mismatch for %v: expected %v, got %v", terraformDefinition, expected, result)
+		}
+	}
+}
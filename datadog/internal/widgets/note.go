@@ -0,0 +1,38 @@
+package widgets
+
+import (
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+)
+
+// NoteMapping describes the note widget; its keys match the API keys one to
+// one.
+var NoteMapping = []FieldMapping{
+	{TerraformKey: "content", Required: true},
+	{TerraformKey: "background_color"},
+	{TerraformKey: "font_size"},
+	{TerraformKey: "text_align"},
+	{TerraformKey: "show_tick"},
+	{TerraformKey: "tick_pos"},
+	{TerraformKey: "tick_edge"},
+}
+
+// BuildNote converts a Terraform note widget definition map to the typed
+// client model.
+func BuildNote(terraformDefinition map[string]interface{}) (*datadogV1.NoteWidgetDefinition, error) {
+	jsonDefinition := BuildJSONDefinition(terraformDefinition, NoteMapping, "note")
+	datadogDefinition := datadogV1.NewNoteWidgetDefinitionWithDefaults()
+	if err := DecodeJSONDefinition(jsonDefinition, datadogDefinition); err != nil {
+		return nil, err
+	}
+	return datadogDefinition, nil
+}
+
+// FlattenNote converts a typed note widget definition back to a Terraform
+// definition map.
+func FlattenNote(datadogDefinition datadogV1.NoteWidgetDefinition) (map[string]interface{}, error) {
+	jsonDefinition, err := EncodeJSONDefinition(datadogDefinition)
+	if err != nil {
+		return nil, err
+	}
+	return FlattenJSONDefinition(jsonDefinition, NoteMapping), nil
+}
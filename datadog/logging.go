@@ -0,0 +1,82 @@
+package datadog
+
+import (
+	"log"
+	"net/http"
+	"net/http/httputil"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
+)
+
+// redactedValue replaces credentials in logged requests and responses.
+const redactedValue = "<redacted>"
+
+// authHeaderPattern matches the Datadog auth headers in dumped requests, so
+// credentials other than the ones the provider is configured with — such as
+// the source org keys of datadog_dashboard_copy — are redacted as well.
+var authHeaderPattern = regexp.MustCompile(`(?mi)^(dd-api-key|dd-application-key): .*$`)
+
+// loggingTransport logs full API request and response bodies when TF_LOG is
+// DEBUG or higher, with the configured API and APP keys redacted wherever they
+// appear (headers for the official clients, query strings for the community
+// client) and any other auth headers blanked. It replaces the stock SDK
+// logging transport, which dumps
+// credentials verbatim, making it safe to share debug logs when diagnosing
+// schema translation bugs.
+type loggingTransport struct {
+	name      string
+	apiKey    string
+	appKey    string
+	telemetry *apiCallTelemetry
+	transport http.RoundTripper
+}
+
+func newLoggingTransport(name, apiKey, appKey string, telemetry *apiCallTelemetry, transport http.RoundTripper) *loggingTransport {
+	return &loggingTransport{
+		name:      name,
+		apiKey:    apiKey,
+		appKey:    appKey,
+		telemetry: telemetry,
+		transport: transport,
+	}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if t.telemetry != nil {
+		t.telemetry.countCall(req.Method, req.URL.Path)
+	}
+	if logging.IsDebugOrHigher() {
+		if reqData, err := httputil.DumpRequestOut(req, true); err == nil {
+			log.Printf("[DEBUG] %s API Request Details:\n---[ REQUEST ]---------------------------------------\n%s\n-----------------------------------------------------", t.name, t.redact(string(reqData)))
+		} else {
+			log.Printf("[ERROR] %s API Request error: %#v", t.name, err)
+		}
+	}
+
+	resp, err := t.transport.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	if logging.IsDebugOrHigher() {
+		if respData, err := httputil.DumpResponse(resp, true); err == nil {
+			log.Printf("[DEBUG] %s API Response Details:\n---[ RESPONSE ]--------------------------------------\n%s\n-----------------------------------------------------", t.name, t.redact(string(respData)))
+		} else {
+			log.Printf("[ERROR] %s API Response error: %#v", t.name, err)
+		}
+	}
+
+	return resp, nil
+}
+
+func (t *loggingTransport) redact(dump string) string {
+	if t.apiKey != "" {
+		dump = strings.ReplaceAll(dump, t.apiKey, redactedValue)
+	}
+	if t.appKey != "" {
+		dump = strings.ReplaceAll(dump, t.appKey, redactedValue)
+	}
+	return authHeaderPattern.ReplaceAllString(dump, "${1}: "+redactedValue)
+}
@@ -0,0 +1,30 @@
+package datadog
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLoggingTransportRedact(t *testing.T) {
+	transport := newLoggingTransport("Datadog", "configured-api-key", "configured-app-key", nil, nil)
+
+	// Auth header values are redacted even when they are not the configured
+	// keys, e.g. the source org keys of datadog_dashboard_copy.
+	dump := strings.Join([]string{
+		"GET /api/v1/dashboard/abc HTTP/1.1",
+		"Dd-Api-Key: source-api-key",
+		"Dd-Application-Key: source-app-key",
+		"",
+		"api_key=configured-api-key&application_key=configured-app-key",
+	}, "\r\n")
+
+	redacted := transport.redact(dump)
+	for _, secret := range []string{"configured-api-key", "configured-app-key", "source-api-key", "source-app-key"} {
+		if strings.Contains(redacted, secret) {
+			t.Errorf("expected %q to be redacted from the dump:\n%s", secret, redacted)
+		}
+	}
+	if !strings.Contains(redacted, "Dd-Api-Key: "+redactedValue) {
+		t.Errorf("expected the api key header to be kept with a redacted value:\n%s", redacted)
+	}
+}
@@ -1,14 +1,22 @@
 package datadog
 
 import (
+	"flag"
 	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
 )
 
-// TestMain starts the tracer.
+// TestMain starts the tracer, or hands over to the sweepers when the -sweep
+// flag is passed, see sweeper_test.go.
 func TestMain(m *testing.M) {
+	flag.Parse()
+	if sweep := flag.Lookup("sweep"); sweep != nil && sweep.Value.String() != "" {
+		resource.TestMain(m)
+		return
+	}
 	service, ok := os.LookupEnv("DD_SERVICE")
 	if !ok {
 		service = "terraform-datadog-provider"
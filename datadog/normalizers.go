@@ -0,0 +1,55 @@
+package datadog
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// Attributes referencing other Datadog objects accept either the bare ID or
+// the web URL users copy from their browser. The helpers below normalize the
+// URL form down to the ID so both spellings produce the same state.
+
+// datadogMonitorReferenceRegexp matches a value which is exactly a monitor
+// web URL, e.g. https://app.datadoghq.com/monitors/123.
+var datadogMonitorReferenceRegexp = regexp.MustCompile(`^https?://[^\s/]*datadoghq[^\s/]*/monitors/([0-9]+)([/?#].*)?$`)
+
+// datadogMonitorURLRegexp matches monitor web URLs embedded in a larger
+// string, such as a composite monitor query.
+var datadogMonitorURLRegexp = regexp.MustCompile(`https?://[^\s/]*datadoghq[^\s/]*/monitors/([0-9]+)`)
+
+// normalizeMonitorReference returns the monitor ID for a monitor web URL and
+// leaves any other value untouched.
+func normalizeMonitorReference(value string) string {
+	if m := datadogMonitorReferenceRegexp.FindStringSubmatch(value); m != nil {
+		return m[1]
+	}
+	return value
+}
+
+// normalizeCompositeMonitorQuery rewrites monitor web URLs inside a composite
+// monitor query down to their numeric IDs, so `<url> || <url>` works the same
+// as `<id> || <id>`.
+func normalizeCompositeMonitorQuery(query string) string {
+	return datadogMonitorURLRegexp.ReplaceAllString(query, "$1")
+}
+
+// normalizeSLOReference returns the SLO ID for an SLO web URL (either the
+// `?slo_id=` shareable link or a `/slo/<id>` path) and leaves any other value
+// untouched.
+func normalizeSLOReference(value string) string {
+	if !strings.HasPrefix(value, "http://") && !strings.HasPrefix(value, "https://") {
+		return value
+	}
+	u, err := url.Parse(value)
+	if err != nil || !strings.Contains(u.Host, "datadoghq") {
+		return value
+	}
+	if id := u.Query().Get("slo_id"); id != "" {
+		return id
+	}
+	if parts := strings.Split(strings.Trim(u.Path, "/"), "/"); len(parts) > 1 && parts[0] == "slo" {
+		return parts[len(parts)-1]
+	}
+	return value
+}
@@ -0,0 +1,83 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// resourcePermissionProbes maps resource types to a harmless GET endpoint
+// guarded by the same permission family as the resource's CRUD endpoints. A
+// 403 on the probe means an apply would fail partway through.
+var resourcePermissionProbes = map[string]string{
+	"datadog_dashboard":               "/api/v1/dashboard",
+	"datadog_dashboard_list":          "/api/v2/dashboard/lists/manual",
+	"datadog_downtime":                "/api/v1/downtime",
+	"datadog_logs_custom_pipeline":    "/api/v1/logs/config/pipelines",
+	"datadog_logs_index":              "/api/v1/logs/config/indexes",
+	"datadog_monitor":                 "/api/v1/monitor?name=terraform-provider-permission-probe",
+	"datadog_role":                    "/api/v2/roles",
+	"datadog_service_level_objective": "/api/v1/slo?limit=1",
+	"datadog_synthetics_test":         "/api/v1/synthetics/tests",
+	"datadog_user":                    "/api/v2/users?page[size]=1",
+}
+
+// withPermissionChecks adds a plan time permission probe to every resource
+// with a known probe endpoint. The probe only runs when the provider's
+// validate_permissions option is set.
+func withPermissionChecks(resources map[string]*schema.Resource) {
+	for resourceType, resource := range resources {
+		path, ok := resourcePermissionProbes[resourceType]
+		if !ok {
+			continue
+		}
+		resource.CustomizeDiff = chainCustomizeDiff(resource.CustomizeDiff, permissionCheckDiff(resourceType, path))
+	}
+}
+
+func chainCustomizeDiff(first, second schema.CustomizeDiffFunc) schema.CustomizeDiffFunc {
+	if first == nil {
+		return second
+	}
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		if err := first(diff, meta); err != nil {
+			return err
+		}
+		return second(diff, meta)
+	}
+}
+
+func permissionCheckDiff(resourceType, path string) schema.CustomizeDiffFunc {
+	return func(diff *schema.ResourceDiff, meta interface{}) error {
+		providerConf, ok := meta.(*ProviderConfiguration)
+		if !ok || !providerConf.validatePermissions {
+			return nil
+		}
+		return providerConf.checkPermission(resourceType, path)
+	}
+}
+
+// checkPermission probes one endpoint per resource type and run, caching the
+// outcome so a plan with many instances of the same type issues one request.
+func (p *ProviderConfiguration) checkPermission(resourceType, path string) error {
+	if cached, ok := p.permissionChecks.Load(resourceType); ok {
+		if cached == nil {
+			return nil
+		}
+		return cached.(error)
+	}
+	var result error
+	httpresp, err := sendRequest(p, http.MethodGet, path, nil, nil)
+	if err != nil && httpresp != nil && (httpresp.StatusCode == http.StatusForbidden || httpresp.StatusCode == http.StatusUnauthorized) {
+		result = fmt.Errorf("the configured keys are not allowed to call `GET %s`, needed by %s; grant the missing permission before applying", path, resourceType)
+	}
+	// Other probe failures (transient errors, endpoint changes) don't block
+	// the plan; apply will surface them if they are real.
+	if result == nil {
+		p.permissionChecks.Store(resourceType, nil)
+		return nil
+	}
+	p.permissionChecks.Store(resourceType, result)
+	return result
+}
@@ -5,15 +5,17 @@ import (
 	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
+	"regexp"
 	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
 	datadogV2 "github.com/DataDog/datadog-api-client-go/api/v2/datadog"
 	"github.com/hashicorp/go-cleanhttp"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/meta"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -53,19 +55,68 @@ func Provider() terraform.ResourceProvider {
 				Default:     true,
 				Description: "Enables validation of the provided API and APP keys during provider initialization. Default is true. When false, api_key and app_keywon't be checked.",
 			},
+			"validate_apm_widget_queries": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Enables plan time validation that APM-based widgets (eg. trace_service) reference existing service and environment names. Default is false.",
+			},
+			"audit_events_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Posts a Datadog event, tagged with the Terraform run metadata, whenever the provider creates, updates or deletes a resource, giving an in-Datadog audit trail of infrastructure-as-code changes. Default is false.",
+			},
+			"validate_permissions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Checks at plan time that the configured keys are allowed to call the endpoints needed by each resource type in the plan, reporting missing permissions instead of failing partway through apply. Default is false.",
+			},
+			"circuit_breaker_threshold": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "Number of consecutive server errors after which the provider stops calling the API and fails fast with a clear diagnostic, instead of timing out request by request during a Datadog outage. Default is 0, which disables the breaker.",
+			},
+			"cache_api_responses": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Memoizes GET responses for the duration of the operation, so objects read several times during plan and refresh only cost one API call each. Default is false.",
+			},
+			"widget_url_allowlist": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of regular expressions; when set, dashboards whose iframe or image widget URLs match none of them are rejected at plan time, enforcing org policy on embedded content.",
+			},
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"datadog_apm_primary_tag":                      resourceDatadogApmPrimaryTag(),
+			"datadog_cloud_cost_aws_cur_config":            resourceDatadogCloudCostAwsCurConfig(),
+			"datadog_cloud_cost_azure_uc_config":           resourceDatadogCloudCostAzureUcConfig(),
+			"datadog_csm_vulnerability_policy":             resourceDatadogCsmVulnerabilityPolicy(),
+			"datadog_custom_allocation_rule":               resourceDatadogCustomAllocationRule(),
 			"datadog_dashboard":                            resourceDatadogDashboard(),
+			"datadog_dashboard_copy":                       resourceDatadogDashboardCopy(),
 			"datadog_dashboard_list":                       resourceDatadogDashboardList(),
+			"datadog_dashboard_permissions":                resourceDatadogDashboardPermissions(),
 			"datadog_downtime":                             resourceDatadogDowntime(),
+			"datadog_error_tracking_auto_assignment":       resourceDatadogErrorTrackingAutoAssignment(),
+			"datadog_error_tracking_inbox_rule":            resourceDatadogErrorTrackingInboxRule(),
+			"datadog_event_correlation_rule":               resourceDatadogEventCorrelationRule(),
 			"datadog_integration_aws":                      resourceDatadogIntegrationAws(),
+			"datadog_integration_aws_event_bridge":         resourceDatadogIntegrationAwsEventBridge(),
 			"datadog_integration_aws_lambda_arn":           resourceDatadogIntegrationAwsLambdaArn(),
 			"datadog_integration_aws_log_collection":       resourceDatadogIntegrationAwsLogCollection(),
 			"datadog_integration_azure":                    resourceDatadogIntegrationAzure(),
 			"datadog_integration_gcp":                      resourceDatadogIntegrationGcp(),
 			"datadog_integration_pagerduty":                resourceDatadogIntegrationPagerduty(),
 			"datadog_integration_pagerduty_service_object": resourceDatadogIntegrationPagerdutySO(),
+			"datadog_integration_slack_channels":           resourceDatadogIntegrationSlackChannels(),
+			"datadog_logs_account_settings":                resourceDatadogLogsAccountSettings(),
 			"datadog_logs_archive":                         resourceDatadogLogsArchive(),
 			"datadog_logs_archive_order":                   resourceDatadogLogsArchiveOrder(),
 			"datadog_logs_custom_pipeline":                 resourceDatadogLogsCustomPipeline(),
@@ -73,38 +124,65 @@ func Provider() terraform.ResourceProvider {
 			"datadog_logs_index_order":                     resourceDatadogLogsIndexOrder(),
 			"datadog_logs_integration_pipeline":            resourceDatadogLogsIntegrationPipeline(),
 			"datadog_logs_pipeline_order":                  resourceDatadogLogsPipelineOrder(),
+			"datadog_logs_restriction_query":               resourceDatadogLogsRestrictionQuery(),
 			"datadog_metric_metadata":                      resourceDatadogMetricMetadata(),
 			"datadog_monitor":                              resourceDatadogMonitor(),
+			"datadog_monitor_mute":                         resourceDatadogMonitorMute(),
+			"datadog_monitor_notification_rule":            resourceDatadogMonitorNotificationRule(),
+			"datadog_reference_table":                      resourceDatadogReferenceTable(),
+			"datadog_remote_configuration":                 resourceDatadogRemoteConfiguration(),
+			"datadog_restriction_policy":                   resourceDatadogRestrictionPolicy(),
 			"datadog_role":                                 resourceDatadogRole(),
+			"datadog_rum_facet":                            resourceDatadogRumFacet(),
+			"datadog_saved_view":                           resourceDatadogSavedView(),
+			"datadog_scorecard_outcome":                    resourceDatadogScorecardOutcome(),
+			"datadog_scorecard_rule":                       resourceDatadogScorecardRule(),
 			"datadog_screenboard":                          resourceDatadogScreenboard(),
 			"datadog_security_monitoring_default_rule":     resourceDatadogSecurityMonitoringDefaultRule(),
+			"datadog_security_monitoring_filter":           resourceDatadogSecurityMonitoringFilter(),
 			"datadog_security_monitoring_rule":             resourceDatadogSecurityMonitoringRule(),
 			"datadog_service_level_objective":              resourceDatadogServiceLevelObjective(),
+			"datadog_shared_dashboard":                     resourceDatadogSharedDashboard(),
 			"datadog_synthetics_test":                      resourceDatadogSyntheticsTest(),
+			"datadog_synthetics_test_json":                 resourceDatadogSyntheticsTestJSON(),
 			"datadog_synthetics_global_variable":           resourceDatadogSyntheticsGlobalVariable(),
 			"datadog_synthetics_private_location":          resourceDatadogSyntheticsPrivateLocation(),
 			"datadog_timeboard":                            resourceDatadogTimeboard(),
+			"datadog_usage_attribution":                    resourceDatadogUsageAttribution(),
 			"datadog_user":                                 resourceDatadogUser(),
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"datadog_dashboard":                 dataSourceDatadogDashboard(),
-			"datadog_dashboard_list":            dataSourceDatadogDashboardList(),
-			"datadog_ip_ranges":                 dataSourceDatadogIpRanges(),
-			"datadog_monitor":                   dataSourceDatadogMonitor(),
-			"datadog_permissions":               dataSourceDatadogPermissions(),
-			"datadog_role":                      dataSourceDatadogRole(),
-			"datadog_security_monitoring_rules": dataSourceDatadogSecurityMonitoringRules(),
-			"datadog_synthetics_locations":      dataSourceDatadogSyntheticsLocations(),
+			"datadog_api_limits":                   dataSourceDatadogApiLimits(),
+			"datadog_audit_logs":                   dataSourceDatadogAuditLogs(),
+			"datadog_dashboard":                    dataSourceDatadogDashboard(),
+			"datadog_dashboard_list":               dataSourceDatadogDashboardList(),
+			"datadog_dashboards":                   dataSourceDatadogDashboards(),
+			"datadog_downtime_matches":             dataSourceDatadogDowntimeMatches(),
+			"datadog_downtimes":                    dataSourceDatadogDowntimes(),
+			"datadog_graph_snapshot":               dataSourceDatadogGraphSnapshot(),
+			"datadog_ip_ranges":                    dataSourceDatadogIpRanges(),
+			"datadog_logs_pipelines":               dataSourceDatadogLogsPipelines(),
+			"datadog_monitor":                      dataSourceDatadogMonitor(),
+			"datadog_notebooks":                    dataSourceDatadogNotebooks(),
+			"datadog_permissions":                  dataSourceDatadogPermissions(),
+			"datadog_role":                         dataSourceDatadogRole(),
+			"datadog_security_monitoring_rules":    dataSourceDatadogSecurityMonitoringRules(),
+			"datadog_service_dependencies":         dataSourceDatadogServiceDependencies(),
+			"datadog_synthetics_locations":         dataSourceDatadogSyntheticsLocations(),
+			"datadog_synthetics_private_locations": dataSourceDatadogSyntheticsPrivateLocations(),
 		},
 
 		ConfigureFunc: providerConfigure,
 	}
 
+	withAuditEvents(datadogProvider.ResourcesMap)
+	withPermissionChecks(datadogProvider.ResourcesMap)
+
 	return datadogProvider
 }
 
-//ProviderConfiguration contains the initialized API clients to communicate with the Datadog API
+// ProviderConfiguration contains the initialized API clients to communicate with the Datadog API
 type ProviderConfiguration struct {
 	CommunityClient *datadogCommunity.Client
 	DatadogClientV1 *datadogV1.APIClient
@@ -112,6 +190,32 @@ type ProviderConfiguration struct {
 	AuthV1          context.Context
 	AuthV2          context.Context
 
+	// Credentials and HTTP client for direct requests against endpoints not
+	// yet covered by the generated clients, see sendRequest.
+	apiKey     string
+	appKey     string
+	apiURL     string
+	httpClient *http.Client
+
+	// Opt-in plan time validation of APM-based widgets
+	validateApmWidgets bool
+
+	// Opt-in audit events for provider-driven changes, see audit.go
+	auditEventsEnabled bool
+
+	// Opt-in plan time permission checks, see permissions_check.go
+	validatePermissions bool
+	permissionChecks    sync.Map
+
+	// Opt-in plan time allowlist for iframe/image widget URLs
+	widgetURLAllowlist []*regexp.Regexp
+
+	// Non-fatal conditions collected while applying, see diagnostics.go
+	diagnostics diagnostics
+
+	// API call counts, see telemetry.go
+	telemetry *apiCallTelemetry
+
 	now func() time.Time
 }
 
@@ -136,7 +240,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 
 	c := cleanhttp.DefaultClient()
-	c.Transport = logging.NewTransport("Datadog", c.Transport)
+	telemetry := newAPICallTelemetry()
+	c.Transport = newLoggingTransport("Datadog", apiKey, appKey, telemetry, c.Transport)
+	if threshold := d.Get("circuit_breaker_threshold").(int); threshold > 0 {
+		c.Transport = newCircuitBreakerTransport(c.Transport, threshold)
+	}
+	if d.Get("cache_api_responses").(bool) {
+		// The cache wraps the logging transport, so cache hits are neither
+		// logged nor counted as API calls.
+		c.Transport = newCachingTransport(c.Transport)
+	}
 	communityClient.ExtraHeader["User-Agent"] = getUserAgent(fmt.Sprintf(
 		"datadog-api-client-go/%s (go %s; os %s; arch %s)",
 		"go-datadog-api",
@@ -162,9 +275,22 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	}
 	log.Printf("[INFO] Datadog Client successfully validated.")
 
+	// Derive the auth contexts from the provider stop context so that
+	// Terraform's cancellation (eg. Ctrl-C) propagates to in-flight API
+	// calls made through the generated clients.
+	ctx := context.Background()
+	if datadogProvider != nil {
+		ctx = datadogProvider.StopContext()
+	}
+	// Dump the API call counts when the operation ends, see telemetry.go
+	go func() {
+		<-ctx.Done()
+		telemetry.logSummary()
+	}()
+
 	// Initialize the official Datadog V1 API client
 	authV1 := context.WithValue(
-		context.Background(),
+		ctx,
 		datadogV1.ContextAPIKeys,
 		map[string]datadogV1.APIKey{
 			"apiKeyAuth": {
@@ -182,7 +308,9 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	configV1.SetUnstableOperationEnabled("UpdateLogsIndex", true)
 	configV1.SetUnstableOperationEnabled("UpdateLogsIndexOrder", true)
 	configV1.UserAgent = getUserAgent(configV1.UserAgent)
-	configV1.Debug = logging.IsDebugOrHigher()
+	// Requests are logged by the redacting loggingTransport rather than the
+	// client debug mode, which would dump credentials verbatim
+	configV1.HTTPClient = c
 	if apiURL := d.Get("api_url").(string); apiURL != "" {
 		parsedApiUrl, parseErr := url.Parse(apiURL)
 		if parseErr != nil {
@@ -221,7 +349,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	// Initialize the official Datadog V2 API client
 	authV2 := context.WithValue(
-		context.Background(),
+		ctx,
 		datadogV2.ContextAPIKeys,
 		map[string]datadogV2.APIKey{
 			"apiKeyAuth": {
@@ -234,7 +362,7 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	)
 	configV2 := datadogV2.NewConfiguration()
 	configV2.UserAgent = getUserAgent(configV2.UserAgent)
-	configV2.Debug = logging.IsDebugOrHigher()
+	configV2.HTTPClient = c
 	if apiURL := d.Get("api_url").(string); apiURL != "" {
 		parsedApiUrl, parseErr := url.Parse(apiURL)
 		if parseErr != nil {
@@ -253,6 +381,15 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	datadogClientV2 := datadogV2.NewAPIClient(configV2)
 
+	widgetURLAllowlist := []*regexp.Regexp{}
+	for _, pattern := range d.Get("widget_url_allowlist").([]interface{}) {
+		compiled, compileErr := regexp.Compile(pattern.(string))
+		if compileErr != nil {
+			return nil, fmt.Errorf(`invalid widget_url_allowlist pattern %q: %v`, pattern, compileErr)
+		}
+		widgetURLAllowlist = append(widgetURLAllowlist, compiled)
+	}
+
 	return &ProviderConfiguration{
 		CommunityClient: communityClient,
 		DatadogClientV1: datadogClientV1,
@@ -260,6 +397,18 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		AuthV1:          authV1,
 		AuthV2:          authV2,
 
+		apiKey:     apiKey,
+		appKey:     appKey,
+		apiURL:     d.Get("api_url").(string),
+		httpClient: c,
+
+		validateApmWidgets:  d.Get("validate_apm_widget_queries").(bool),
+		auditEventsEnabled:  d.Get("audit_events_enabled").(bool),
+		validatePermissions: d.Get("validate_permissions").(bool),
+		widgetURLAllowlist:  widgetURLAllowlist,
+
+		telemetry: telemetry,
+
 		now: time.Now,
 	}, nil
 }
@@ -1,12 +1,9 @@
 package datadog
 
 import (
-	"bytes"
 	"context"
 	"crypto/sha256"
 	"fmt"
-	"io/ioutil"
-	"log"
 	"net/http"
 	"net/url"
 	"os"
@@ -15,11 +12,9 @@ import (
 	"strconv"
 	"strings"
 	"testing"
-	"time"
 
 	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
 	datadogV2 "github.com/DataDog/datadog-api-client-go/api/v2/datadog"
-	"github.com/dnaeon/go-vcr/cassette"
 	"github.com/dnaeon/go-vcr/recorder"
 	"github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
@@ -31,6 +26,8 @@ import (
 	ddhttp "gopkg.in/DataDog/dd-trace-go.v1/contrib/net/http"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/ext"
 	"gopkg.in/DataDog/dd-trace-go.v1/ddtrace/tracer"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/replay"
 )
 
 var testFiles2EndpointTags = map[string]string{
@@ -135,11 +132,11 @@ func getEndpointTagValue(t *testing.T) (string, error) {
 }
 
 func isRecording() bool {
-	return os.Getenv("RECORD") == "true"
+	return replay.IsRecording()
 }
 
 func isReplaying() bool {
-	return os.Getenv("RECORD") == "false"
+	return replay.IsReplaying()
 }
 
 func isDebug() bool {
@@ -171,39 +168,8 @@ func isCIRun() bool {
 	return os.Getenv("CI") == "true"
 }
 
-func setClock(t *testing.T) clockwork.FakeClock {
-	os.MkdirAll("cassettes", 0755)
-	f, err := os.Create(fmt.Sprintf("cassettes/%s.freeze", t.Name()))
-	if err != nil {
-		t.Fatalf("Could not set clock: %v", err)
-	}
-	defer f.Close()
-	now := clockwork.NewRealClock().Now()
-	f.WriteString(now.Format(time.RFC3339Nano))
-	return clockwork.NewFakeClockAt(now)
-}
-
-func restoreClock(t *testing.T) clockwork.FakeClock {
-	data, err := ioutil.ReadFile(fmt.Sprintf("cassettes/%s.freeze", t.Name()))
-	if err != nil {
-		t.Logf("Could not load clock: %v", err)
-		return setClock(t)
-	}
-	now, err := time.Parse(time.RFC3339Nano, string(data))
-	if err != nil {
-		t.Fatalf("Could not parse clock date: %v", err)
-	}
-	return clockwork.NewFakeClockAt(now)
-}
-
 func testClock(t *testing.T) clockwork.FakeClock {
-	if isRecording() {
-		return setClock(t)
-	} else if isReplaying() {
-		return restoreClock(t)
-	}
-	// do not set or restore frozen time
-	return clockwork.NewFakeClockAt(clockwork.NewRealClock().Now())
+	return replay.Clock(t)
 }
 
 // uniqueEntityName will return a unique string that can be used as a title/description/summary/...
@@ -253,87 +219,8 @@ func uniqueAWSAccountID(clock clockwork.FakeClock, t *testing.T) string {
 	return result[:12]
 }
 
-func removeURLSecrets(u *url.URL) *url.URL {
-	query := u.Query()
-	query.Del("api_key")
-	query.Del("application_key")
-	u.RawQuery = query.Encode()
-	return u
-}
-
 func initRecorder(t *testing.T) *recorder.Recorder {
-	var mode recorder.Mode
-	if isRecording() {
-		mode = recorder.ModeRecording
-	} else if isReplaying() {
-		mode = recorder.ModeReplaying
-	} else {
-		mode = recorder.ModeDisabled
-	}
-
-	rec, err := recorder.NewAsMode(fmt.Sprintf("cassettes/%s", t.Name()), mode, nil)
-	if err != nil {
-		log.Fatal(err)
-	}
-
-	rec.SetMatcher(matchInteraction)
-
-	rec.AddFilter(func(i *cassette.Interaction) error {
-		u, err := url.Parse(i.URL)
-		if err != nil {
-			return err
-		}
-		i.URL = removeURLSecrets(u).String()
-		i.Request.Headers.Del("Dd-Api-Key")
-		i.Request.Headers.Del("Dd-Application-Key")
-		return nil
-	})
-	return rec
-}
-
-// matchInteraction checks if the request matches a store request in the given cassette.
-func matchInteraction(r *http.Request, i cassette.Request) bool {
-	// Default matching on method and URL without secrets
-	if !(r.Method == i.Method && removeURLSecrets(r.URL).String() == i.URL) {
-		log.Printf("HTTP method: %s != %s; URL: %s != %s", r.Method, i.Method, removeURLSecrets(r.URL), i.URL)
-		return false
-	}
-
-	// Request does not contain body (e.g. `GET`)
-	if r.Body == nil {
-		log.Printf("request body is empty and cassette body is: %s", i.Body)
-		return i.Body == ""
-	}
-
-	// Load request body
-	var b bytes.Buffer
-	if _, err := b.ReadFrom(r.Body); err != nil {
-		log.Printf("could not read request body: %v\n", err)
-		return false
-	}
-	r.Body = ioutil.NopCloser(&b)
-
-	matched := b.String() == "" || b.String() == i.Body
-
-	// Ignore boundary differences for multipart/form-data content
-	if !matched && strings.HasPrefix(r.Header["Content-Type"][0], "multipart/form-data") {
-		rl := strings.Split(strings.TrimSpace(b.String()), "\n")
-		cl := strings.Split(strings.TrimSpace(i.Body), "\n")
-		if len(rl) > 1 && len(cl) > 1 {
-			rs := strings.Join(rl[1:len(rl)-1], "\n")
-			cs := strings.Join(cl[1:len(cl)-1], "\n")
-			if rs == cs {
-				matched = true
-			}
-		}
-	}
-
-	if !matched {
-		log.Printf("%s != %s", b.String(), i.Body)
-		log.Printf("full cassette info: %v", i)
-		log.Printf("full request info: %v", *r)
-	}
-	return matched
+	return replay.Recorder(t)
 }
 
 func testSpan(ctx context.Context, t *testing.T) (context.Context, func()) {
@@ -463,6 +350,11 @@ func testProviderConfigure(ctx context.Context, httpClient *http.Client, clock c
 			AuthV1:          ctx,
 			AuthV2:          ctx,
 
+			apiKey:     d.Get("api_key").(string),
+			appKey:     d.Get("app_key").(string),
+			apiURL:     d.Get("api_url").(string),
+			httpClient: c,
+
 			now: clock.Now,
 		}, nil
 	}
@@ -0,0 +1,106 @@
+package datadog
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// defaultAPIURL is the API endpoint used by direct requests when no custom
+// api_url is configured on the provider.
+const defaultAPIURL = "https://api.datadoghq.com"
+
+// isTransientError returns whether an API call failed for a reason worth
+// retrying: a gateway error (502, 503 or 504) or a network error that never
+// produced a response.
+func isTransientError(httpResponse *http.Response, err error) bool {
+	if err == nil {
+		return false
+	}
+	if httpResponse == nil {
+		return true
+	}
+	switch httpResponse.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// sendRequest performs an authenticated JSON request against an API endpoint
+// that is not yet covered by the generated Datadog clients. The path must
+// start with "/api/". When out is non-nil, a successful response body is
+// decoded into it. The raw *http.Response is always returned when available so
+// callers can inspect the status code (e.g. to clear state on a 404).
+func sendRequest(providerConf *ProviderConfiguration, method, path string, body interface{}, out interface{}) (*http.Response, error) {
+	apiURL := providerConf.apiURL
+	if apiURL == "" {
+		apiURL = defaultAPIURL
+	}
+
+	var reqBody io.Reader
+	if body != nil {
+		marshaled, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		reqBody = bytes.NewReader(marshaled)
+	}
+
+	req, err := http.NewRequest(method, strings.TrimSuffix(apiURL, "/")+path, reqBody)
+	if err != nil {
+		return nil, err
+	}
+	// AuthV1 is derived from the provider stop context, propagating
+	// Terraform's cancellation to direct requests as well
+	if providerConf.AuthV1 != nil {
+		req = req.WithContext(providerConf.AuthV1)
+	}
+	req.Header.Set("DD-API-KEY", providerConf.apiKey)
+	req.Header.Set("DD-APPLICATION-KEY", providerConf.appKey)
+	req.Header.Set("User-Agent", getUserAgent(""))
+	// Asking for gzip explicitly keeps multi-MB payloads (e.g. very large
+	// dashboards) small on the wire; decompression is handled below since
+	// setting the header disables the transport's transparent handling.
+	req.Header.Set("Accept-Encoding", "gzip")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := providerConf.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var bodyReader io.Reader = resp.Body
+	if resp.Header.Get("Content-Encoding") == "gzip" {
+		gzipReader, err := gzip.NewReader(resp.Body)
+		if err != nil {
+			return resp, err
+		}
+		defer gzipReader.Close()
+		bodyReader = gzipReader
+	}
+	if deprecation := resp.Header.Get("Deprecation"); deprecation != "" {
+		providerConf.appendWarning("datadog", "`%s %s` is deprecated by the API (Deprecation: %s)", method, path, deprecation)
+	}
+	if resp.StatusCode >= 300 {
+		respBody, _ := ioutil.ReadAll(bodyReader)
+		return resp, fmt.Errorf("error when calling `%s %s`: %s: %s", method, path, resp.Status, strings.TrimSpace(string(respBody)))
+	}
+	if out != nil {
+		// Decode straight off the wire instead of buffering the whole
+		// body, so very large responses don't need a second copy in
+		// memory.
+		if err := json.NewDecoder(bodyReader).Decode(out); err != nil && err != io.EOF {
+			return resp, err
+		}
+	}
+	return resp, nil
+}
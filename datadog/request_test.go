@@ -0,0 +1,35 @@
+package datadog
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// testProviderConfForServer returns a ProviderConfiguration whose direct
+// requests hit the given test server, so resources built on sendRequest can be
+// exercised in unit tests without cassettes.
+func testProviderConfForServer(server *httptest.Server) *ProviderConfiguration {
+	if datadogProvider == nil {
+		// getUserAgent reads the package-level provider.
+		Provider()
+	}
+	return &ProviderConfiguration{
+		apiKey:     "test-api-key",
+		appKey:     "test-app-key",
+		apiURL:     server.URL,
+		httpClient: server.Client(),
+	}
+}
+
+// decodeTestRequestBody decodes the JSON body of a request captured by a test
+// server handler.
+func decodeTestRequestBody(t *testing.T, r *http.Request) map[string]interface{} {
+	t.Helper()
+	body := map[string]interface{}{}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		t.Fatalf("failed to decode request body: %s", err)
+	}
+	return body
+}
@@ -0,0 +1,97 @@
+package datadog
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// apmSecondPrimaryTagPath is the APM second primary tag config endpoint,
+// which the pinned API client does not cover; requests go through sendRequest.
+const apmSecondPrimaryTagPath = "/api/v2/apm/config/second-primary-tag"
+
+func resourceDatadogApmPrimaryTag() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog APM second primary tag configuration resource. The configuration is org wide, so only one instance of this resource is needed; it lets primary tag rollouts be coordinated with agent config changes.",
+		Create:      resourceDatadogApmPrimaryTagCreate,
+		Read:        resourceDatadogApmPrimaryTagRead,
+		Update:      resourceDatadogApmPrimaryTagUpdate,
+		Delete:      resourceDatadogApmPrimaryTagDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tag": {
+				Description: "Tag key used as the second primary tag of APM, e.g. `datacenter`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func updateApmSecondPrimaryTag(providerConf *ProviderConfiguration, tag string) error {
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "apm_config",
+			"attributes": map[string]interface{}{
+				"tag": tag,
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPut, apmSecondPrimaryTagPath, payload, nil); err != nil {
+		return translateClientError(err, "error updating APM second primary tag")
+	}
+	return nil
+}
+
+func resourceDatadogApmPrimaryTagCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if err := updateApmSecondPrimaryTag(providerConf, d.Get("tag").(string)); err != nil {
+		return err
+	}
+	// The configuration is an org wide singleton.
+	d.SetId("apm-second-primary-tag")
+
+	return resourceDatadogApmPrimaryTagRead(d, meta)
+}
+
+func resourceDatadogApmPrimaryTagRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, apmSecondPrimaryTagPath, nil, &response); err != nil {
+		return translateClientError(err, "error getting APM second primary tag")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	tag, _ := attributes["tag"].(string)
+	if tag == "" {
+		// An empty tag means the configuration was removed outside Terraform.
+		d.SetId("")
+		return nil
+	}
+	d.Set("tag", tag)
+
+	return nil
+}
+
+func resourceDatadogApmPrimaryTagUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if err := updateApmSecondPrimaryTag(providerConf, d.Get("tag").(string)); err != nil {
+		return err
+	}
+
+	return resourceDatadogApmPrimaryTagRead(d, meta)
+}
+
+func resourceDatadogApmPrimaryTagDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	// The API has no delete call for the configuration; clearing the tag
+	// disables it.
+	return updateApmSecondPrimaryTag(providerConf, "")
+}
@@ -0,0 +1,40 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestApmPrimaryTagCreate(t *testing.T) {
+	var putBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != apmSecondPrimaryTagPath {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Method == http.MethodPut {
+			putBody = decodeTestRequestBody(t, r)
+		}
+		fmt.Fprint(w, `{"data": {"type": "apm_config", "attributes": {"tag": "datacenter"}}}`)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceDatadogApmPrimaryTag().Schema, map[string]interface{}{
+		"tag": "datacenter",
+	})
+	if err := resourceDatadogApmPrimaryTagCreate(d, testProviderConfForServer(server)); err != nil {
+		t.Fatalf("create returned an error: %s", err)
+	}
+
+	if d.Id() != "apm-second-primary-tag" {
+		t.Errorf("expected the singleton ID, got %q", d.Id())
+	}
+	data, _ := putBody["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if data["type"] != "apm_config" || attributes["tag"] != "datacenter" {
+		t.Errorf("unexpected update payload: %v", putBody)
+	}
+}
@@ -0,0 +1,166 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// cloudCostAwsCurConfigsPath is the Cloud Cost Management AWS CUR config
+// endpoint, not covered by the pinned API client.
+const cloudCostAwsCurConfigsPath = "/api/v2/cost/aws_cur_config"
+
+func resourceDatadogCloudCostAwsCurConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Cloud Cost Management AWS Cost and Usage Report (CUR) config resource, so cost ingestion is provisioned together with the exporting infrastructure.",
+		Create:      resourceDatadogCloudCostAwsCurConfigCreate,
+		Read:        resourceDatadogCloudCostAwsCurConfigRead,
+		Update:      resourceDatadogCloudCostAwsCurConfigUpdate,
+		Delete:      resourceDatadogCloudCostAwsCurConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "ID of the AWS account that owns the Cost and Usage Report.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"bucket_name": {
+				Description: "Name of the S3 bucket the Cost and Usage Report is delivered to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"bucket_region": {
+				Description: "Region of the S3 bucket the Cost and Usage Report is delivered to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"report_name": {
+				Description: "Name of the Cost and Usage Report.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"report_prefix": {
+				Description: "Prefix under which the Cost and Usage Report is delivered.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"is_enabled": {
+				Description: "Whether the Cost and Usage Report is ingested.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			// Computed values
+			"status": {
+				Description: "Status of the Cost and Usage Report ingestion.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func buildCloudCostAwsCurConfigPayload(d *schema.ResourceData) map[string]interface{} {
+	attributes := map[string]interface{}{
+		"account_id":    d.Get("account_id").(string),
+		"bucket_name":   d.Get("bucket_name").(string),
+		"report_name":   d.Get("report_name").(string),
+		"report_prefix": d.Get("report_prefix").(string),
+		"is_enabled":    d.Get("is_enabled").(bool),
+	}
+	if v, ok := d.GetOk("bucket_region"); ok {
+		attributes["bucket_region"] = v.(string)
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "aws_cur_config",
+			"attributes": attributes,
+		},
+	}
+}
+
+func resourceDatadogCloudCostAwsCurConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, cloudCostAwsCurConfigsPath, buildCloudCostAwsCurConfigPayload(d), &response); err != nil {
+		return translateClientError(err, "error creating AWS CUR config")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, ok := data["id"].(string)
+	if !ok {
+		return fmt.Errorf("error creating AWS CUR config: no id in response")
+	}
+	d.SetId(id)
+
+	return resourceDatadogCloudCostAwsCurConfigRead(d, meta)
+}
+
+func resourceDatadogCloudCostAwsCurConfigRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	// The list endpoint is the only read endpoint for these configs.
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, cloudCostAwsCurConfigsPath, nil, &response); err != nil {
+		return translateClientError(err, "error getting AWS CUR configs")
+	}
+	configs, _ := response["data"].([]interface{})
+	for _, c := range configs {
+		config, _ := c.(map[string]interface{})
+		if config["id"] != d.Id() {
+			continue
+		}
+		attributes, _ := config["attributes"].(map[string]interface{})
+		d.Set("account_id", attributes["account_id"])
+		d.Set("bucket_name", attributes["bucket_name"])
+		d.Set("bucket_region", attributes["bucket_region"])
+		d.Set("report_name", attributes["report_name"])
+		d.Set("report_prefix", attributes["report_prefix"])
+		if isEnabled, ok := attributes["is_enabled"].(bool); ok {
+			d.Set("is_enabled", isEnabled)
+		}
+		d.Set("status", attributes["status"])
+		return nil
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceDatadogCloudCostAwsCurConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "aws_cur_config",
+			"id":   d.Id(),
+			"attributes": map[string]interface{}{
+				"is_enabled": d.Get("is_enabled").(bool),
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPatch, cloudCostAwsCurConfigsPath+"/"+d.Id(), payload, nil); err != nil {
+		return translateClientError(err, "error updating AWS CUR config")
+	}
+
+	return resourceDatadogCloudCostAwsCurConfigRead(d, meta)
+}
+
+func resourceDatadogCloudCostAwsCurConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, cloudCostAwsCurConfigsPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting AWS CUR config")
+	}
+
+	return nil
+}
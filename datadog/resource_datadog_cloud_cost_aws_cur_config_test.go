@@ -0,0 +1,38 @@
+package datadog
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildCloudCostAwsCurConfigPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogCloudCostAwsCurConfig().Schema, map[string]interface{}{
+		"account_id":    "123456789012",
+		"bucket_name":   "billing-bucket",
+		"report_name":   "cur-report",
+		"report_prefix": "reports/",
+	})
+
+	payload := buildCloudCostAwsCurConfigPayload(d)
+	data := payload["data"].(map[string]interface{})
+	attributes := data["attributes"].(map[string]interface{})
+	if data["type"] != "aws_cur_config" {
+		t.Errorf("unexpected payload type %v", data["type"])
+	}
+	if attributes["account_id"] != "123456789012" || attributes["bucket_name"] != "billing-bucket" {
+		t.Errorf("unexpected attributes: %v", attributes)
+	}
+	if attributes["is_enabled"] != true {
+		t.Errorf("expected is_enabled to default to true, got %v", attributes["is_enabled"])
+	}
+	if _, ok := attributes["bucket_region"]; ok {
+		t.Errorf("expected bucket_region to be omitted when unset, got %v", attributes["bucket_region"])
+	}
+
+	d.Set("bucket_region", "us-east-1")
+	attributes = buildCloudCostAwsCurConfigPayload(d)["data"].(map[string]interface{})["attributes"].(map[string]interface{})
+	if attributes["bucket_region"] != "us-east-1" {
+		t.Errorf("expected bucket_region to be included, got %v", attributes["bucket_region"])
+	}
+}
@@ -0,0 +1,179 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// cloudCostAzureUcConfigsPath is the Cloud Cost Management Azure UC config
+// endpoint; the pinned API client has no bindings for it.
+const cloudCostAzureUcConfigsPath = "/api/v2/cost/azure_uc_config"
+
+func resourceDatadogCloudCostAzureUcConfig() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Cloud Cost Management Azure usage and charges (UC) export config resource, so cost ingestion is provisioned together with the exporting infrastructure.",
+		Create:      resourceDatadogCloudCostAzureUcConfigCreate,
+		Read:        resourceDatadogCloudCostAzureUcConfigRead,
+		Update:      resourceDatadogCloudCostAzureUcConfigUpdate,
+		Delete:      resourceDatadogCloudCostAzureUcConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "ID of the Azure tenant the export belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"client_id": {
+				Description: "ID of the Azure client used to read the export.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"scope": {
+				Description: "Scope the usage and charges export is created on, e.g. `subscriptions/1234abcd-...`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"export_name": {
+				Description: "Name of the usage and charges export.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"export_path": {
+				Description: "Path the usage and charges export is delivered under.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"storage_account": {
+				Description: "Name of the storage account the export is delivered to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"storage_container": {
+				Description: "Name of the storage container the export is delivered to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"is_enabled": {
+				Description: "Whether the usage and charges export is ingested.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			// Computed values
+			"status": {
+				Description: "Status of the usage and charges export ingestion.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func buildCloudCostAzureUcConfigPayload(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "azure_uc_config",
+			"attributes": map[string]interface{}{
+				"account_id":        d.Get("account_id").(string),
+				"client_id":         d.Get("client_id").(string),
+				"scope":             d.Get("scope").(string),
+				"export_name":       d.Get("export_name").(string),
+				"export_path":       d.Get("export_path").(string),
+				"storage_account":   d.Get("storage_account").(string),
+				"storage_container": d.Get("storage_container").(string),
+				"is_enabled":        d.Get("is_enabled").(bool),
+			},
+		},
+	}
+}
+
+func resourceDatadogCloudCostAzureUcConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, cloudCostAzureUcConfigsPath, buildCloudCostAzureUcConfigPayload(d), &response); err != nil {
+		return translateClientError(err, "error creating Azure UC config")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, ok := data["id"].(string)
+	if !ok {
+		return fmt.Errorf("error creating Azure UC config: no id in response")
+	}
+	d.SetId(id)
+
+	return resourceDatadogCloudCostAzureUcConfigRead(d, meta)
+}
+
+func resourceDatadogCloudCostAzureUcConfigRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	// The list endpoint is the only read endpoint for these configs.
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, cloudCostAzureUcConfigsPath, nil, &response); err != nil {
+		return translateClientError(err, "error getting Azure UC configs")
+	}
+	configs, _ := response["data"].([]interface{})
+	for _, c := range configs {
+		config, _ := c.(map[string]interface{})
+		if config["id"] != d.Id() {
+			continue
+		}
+		attributes, _ := config["attributes"].(map[string]interface{})
+		d.Set("account_id", attributes["account_id"])
+		d.Set("client_id", attributes["client_id"])
+		d.Set("scope", attributes["scope"])
+		d.Set("export_name", attributes["export_name"])
+		d.Set("export_path", attributes["export_path"])
+		d.Set("storage_account", attributes["storage_account"])
+		d.Set("storage_container", attributes["storage_container"])
+		if isEnabled, ok := attributes["is_enabled"].(bool); ok {
+			d.Set("is_enabled", isEnabled)
+		}
+		d.Set("status", attributes["status"])
+		return nil
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceDatadogCloudCostAzureUcConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "azure_uc_config",
+			"id":   d.Id(),
+			"attributes": map[string]interface{}{
+				"is_enabled": d.Get("is_enabled").(bool),
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPatch, cloudCostAzureUcConfigsPath+"/"+d.Id(), payload, nil); err != nil {
+		return translateClientError(err, "error updating Azure UC config")
+	}
+
+	return resourceDatadogCloudCostAzureUcConfigRead(d, meta)
+}
+
+func resourceDatadogCloudCostAzureUcConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, cloudCostAzureUcConfigsPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting Azure UC config")
+	}
+
+	return nil
+}
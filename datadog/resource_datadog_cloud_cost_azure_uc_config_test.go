@@ -0,0 +1,40 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildCloudCostAzureUcConfigPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogCloudCostAzureUcConfig().Schema, map[string]interface{}{
+		"account_id":        "1234abcd-aaaa-bbbb-cccc-1234abcd5678",
+		"client_id":         "abcd1234-dddd-eeee-ffff-abcd12345678",
+		"scope":             "subscriptions/1234abcd-aaaa-bbbb-cccc-1234abcd5678",
+		"export_name":       "costs",
+		"export_path":       "datadog/costs",
+		"storage_account":   "billingstorage",
+		"storage_container": "exports",
+	})
+
+	payload := buildCloudCostAzureUcConfigPayload(d)
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "azure_uc_config",
+			"attributes": map[string]interface{}{
+				"account_id":        "1234abcd-aaaa-bbbb-cccc-1234abcd5678",
+				"client_id":         "abcd1234-dddd-eeee-ffff-abcd12345678",
+				"scope":             "subscriptions/1234abcd-aaaa-bbbb-cccc-1234abcd5678",
+				"export_name":       "costs",
+				"export_path":       "datadog/costs",
+				"storage_account":   "billingstorage",
+				"storage_container": "exports",
+				"is_enabled":        true,
+			},
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
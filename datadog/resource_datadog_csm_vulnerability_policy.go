@@ -0,0 +1,159 @@
+package datadog
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// csmVulnerabilityPoliciesPath is the CSM vulnerability policies endpoint,
+// called directly since the pinned API client does not model it.
+const csmVulnerabilityPoliciesPath = "/api/v2/csm/vulnerability_policies"
+
+func resourceDatadogCsmVulnerabilityPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Cloud Security Management vulnerability policy resource. This can be used to keep container image and SBOM vulnerability thresholds and scopes consistent across organizations.",
+		Create:      resourceDatadogCsmVulnerabilityPolicyCreate,
+		Read:        resourceDatadogCsmVulnerabilityPolicyRead,
+		Update:      resourceDatadogCsmVulnerabilityPolicyUpdate,
+		Delete:      resourceDatadogCsmVulnerabilityPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "Name of the vulnerability policy.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"enabled": {
+				Description: "Whether the policy is evaluated. Defaults to true.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"target": {
+				Description:  "What the policy evaluates, either `container_image` or `host`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true, // waits for update API call support
+				ValidateFunc: validation.StringInSlice([]string{"container_image", "host"}, false),
+			},
+			"scope": {
+				Description: "Query restricting which images or hosts the policy applies to, e.g. `env:prod`. An empty scope applies the policy everywhere.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"severity_threshold": {
+				Description:  "Minimum severity of a vulnerability for it to violate the policy, one of `critical`, `high`, `medium`, `low` or `info`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"critical", "high", "medium", "low", "info"}, false),
+			},
+			"only_fixable": {
+				Description: "Only count vulnerabilities that have a fix available. Defaults to false.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
+			"age_threshold_days": {
+				Description: "Only count vulnerabilities that have been disclosed for at least this many days. Defaults to 0 (count immediately).",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+		},
+	}
+}
+
+func buildCsmVulnerabilityPolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "vulnerability_policies",
+			"attributes": map[string]interface{}{
+				"name":               d.Get("name").(string),
+				"enabled":            d.Get("enabled").(bool),
+				"target":             d.Get("target").(string),
+				"scope":              d.Get("scope").(string),
+				"severity_threshold": d.Get("severity_threshold").(string),
+				"only_fixable":       d.Get("only_fixable").(bool),
+				"age_threshold_days": d.Get("age_threshold_days").(int),
+			},
+		},
+	}
+}
+
+func resourceDatadogCsmVulnerabilityPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, csmVulnerabilityPoliciesPath, buildCsmVulnerabilityPolicyPayload(d), &response); err != nil {
+		return translateClientError(err, "error creating CSM vulnerability policy")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, _ := data["id"].(string)
+	d.SetId(id)
+
+	return resourceDatadogCsmVulnerabilityPolicyRead(d, meta)
+}
+
+func resourceDatadogCsmVulnerabilityPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, csmVulnerabilityPoliciesPath+"/"+d.Id(), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting CSM vulnerability policy")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if name, ok := attributes["name"].(string); ok {
+		d.Set("name", name)
+	}
+	if enabled, ok := attributes["enabled"].(bool); ok {
+		d.Set("enabled", enabled)
+	}
+	if target, ok := attributes["target"].(string); ok {
+		d.Set("target", target)
+	}
+	if scope, ok := attributes["scope"].(string); ok {
+		d.Set("scope", scope)
+	}
+	if severityThreshold, ok := attributes["severity_threshold"].(string); ok {
+		d.Set("severity_threshold", severityThreshold)
+	}
+	if onlyFixable, ok := attributes["only_fixable"].(bool); ok {
+		d.Set("only_fixable", onlyFixable)
+	}
+	if ageThresholdDays, ok := attributes["age_threshold_days"].(float64); ok {
+		d.Set("age_threshold_days", int(ageThresholdDays))
+	}
+
+	return nil
+}
+
+func resourceDatadogCsmVulnerabilityPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodPatch, csmVulnerabilityPoliciesPath+"/"+d.Id(), buildCsmVulnerabilityPolicyPayload(d), nil); err != nil {
+		return translateClientError(err, "error updating CSM vulnerability policy")
+	}
+
+	return resourceDatadogCsmVulnerabilityPolicyRead(d, meta)
+}
+
+func resourceDatadogCsmVulnerabilityPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, csmVulnerabilityPoliciesPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting CSM vulnerability policy")
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildCsmVulnerabilityPolicyPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogCsmVulnerabilityPolicy().Schema, map[string]interface{}{
+		"name":               "No critical CVEs in prod",
+		"target":             "container_image",
+		"scope":              "env:prod",
+		"severity_threshold": "critical",
+		"only_fixable":       true,
+	})
+
+	payload := buildCsmVulnerabilityPolicyPayload(d)
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "vulnerability_policies",
+			"attributes": map[string]interface{}{
+				"name":               "No critical CVEs in prod",
+				"enabled":            true,
+				"target":             "container_image",
+				"scope":              "env:prod",
+				"severity_threshold": "critical",
+				"only_fixable":       true,
+				"age_threshold_days": 0,
+			},
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
@@ -0,0 +1,225 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// customAllocationRulesPath is the custom allocation rules endpoint of Cloud
+// Cost Management, missing from the pinned API client.
+const customAllocationRulesPath = "/api/v2/cost/custom_allocation_rules"
+
+// customAllocationRuleFilter is the schema shared by the cost filters of an
+// allocation rule.
+func customAllocationRuleFilter(description string) *schema.Schema {
+	return &schema.Schema{
+		Description: description,
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"tag": {
+					Description: "Tag key the filter applies to.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"condition": {
+					Description:  "Condition applied to the tag. Valid values are `is`, `is not`, `in`, `not in`.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validation.StringInSlice([]string{"is", "is not", "in", "not in"}, false),
+				},
+				"values": {
+					Description: "Values the tag is compared against.",
+					Type:        schema.TypeList,
+					Required:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+			},
+		},
+	}
+}
+
+func resourceDatadogCustomAllocationRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Cloud Cost Management custom allocation rule resource, so showback mappings are reviewed in PRs.",
+		Create:      resourceDatadogCustomAllocationRuleCreate,
+		Read:        resourceDatadogCustomAllocationRuleRead,
+		Update:      resourceDatadogCustomAllocationRuleUpdate,
+		Delete:      resourceDatadogCustomAllocationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rule_name": {
+				Description: "Name of the allocation rule.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"enabled": {
+				Description: "Whether the rule is applied.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"costs_to_allocate": customAllocationRuleFilter("Filters selecting the costs the rule allocates."),
+			"strategy": {
+				Description: "How the selected costs are allocated.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"method": {
+							Description:  "Allocation method. Valid values are `even`, `proportional`, `percent`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"even", "proportional", "percent"}, false),
+						},
+						"allocated_by_tag_keys": {
+							Description: "Tag keys the costs are split across.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"based_on_costs": customAllocationRuleFilter("Filters selecting the costs the proportional split is based on."),
+						"granularity": {
+							Description:  "Granularity at which the allocation is computed. Valid values are `daily`, `monthly`.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "daily",
+							ValidateFunc: validation.StringInSlice([]string{"daily", "monthly"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildCustomAllocationRuleFilters(raw interface{}) []map[string]interface{} {
+	filters := []map[string]interface{}{}
+	rawFilters, _ := raw.([]interface{})
+	for _, f := range rawFilters {
+		filter := f.(map[string]interface{})
+		values := []string{}
+		for _, v := range filter["values"].([]interface{}) {
+			values = append(values, v.(string))
+		}
+		filters = append(filters, map[string]interface{}{
+			"tag":       filter["tag"],
+			"condition": filter["condition"],
+			"values":    values,
+		})
+	}
+	return filters
+}
+
+func buildCustomAllocationRulePayload(d *schema.ResourceData) map[string]interface{} {
+	strategy := d.Get("strategy.0").(map[string]interface{})
+	attributes := map[string]interface{}{
+		"rule_name":         d.Get("rule_name").(string),
+		"enabled":           d.Get("enabled").(bool),
+		"costs_to_allocate": buildCustomAllocationRuleFilters(d.Get("costs_to_allocate")),
+		"strategy": map[string]interface{}{
+			"method":                strategy["method"],
+			"allocated_by_tag_keys": strategy["allocated_by_tag_keys"],
+			"based_on_costs":        buildCustomAllocationRuleFilters(strategy["based_on_costs"]),
+			"granularity":           strategy["granularity"],
+		},
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "custom_allocation_rule",
+			"attributes": attributes,
+		},
+	}
+}
+
+func flattenCustomAllocationRuleFilters(raw interface{}) []map[string]interface{} {
+	filters := []map[string]interface{}{}
+	rawFilters, _ := raw.([]interface{})
+	for _, f := range rawFilters {
+		filter, _ := f.(map[string]interface{})
+		filters = append(filters, map[string]interface{}{
+			"tag":       filter["tag"],
+			"condition": filter["condition"],
+			"values":    filter["values"],
+		})
+	}
+	return filters
+}
+
+func resourceDatadogCustomAllocationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, customAllocationRulesPath, buildCustomAllocationRulePayload(d), &response); err != nil {
+		return translateClientError(err, "error creating custom allocation rule")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, ok := data["id"].(string)
+	if !ok {
+		return fmt.Errorf("error creating custom allocation rule: no id in response")
+	}
+	d.SetId(id)
+
+	return resourceDatadogCustomAllocationRuleRead(d, meta)
+}
+
+func resourceDatadogCustomAllocationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, customAllocationRulesPath+"/"+d.Id(), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting custom allocation rule")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	d.Set("rule_name", attributes["rule_name"])
+	if enabled, ok := attributes["enabled"].(bool); ok {
+		d.Set("enabled", enabled)
+	}
+	d.Set("costs_to_allocate", flattenCustomAllocationRuleFilters(attributes["costs_to_allocate"]))
+	if strategy, ok := attributes["strategy"].(map[string]interface{}); ok {
+		d.Set("strategy", []map[string]interface{}{{
+			"method":                strategy["method"],
+			"allocated_by_tag_keys": strategy["allocated_by_tag_keys"],
+			"based_on_costs":        flattenCustomAllocationRuleFilters(strategy["based_on_costs"]),
+			"granularity":           strategy["granularity"],
+		}})
+	}
+
+	return nil
+}
+
+func resourceDatadogCustomAllocationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload := buildCustomAllocationRulePayload(d)
+	payload["data"].(map[string]interface{})["id"] = d.Id()
+	if _, err := sendRequest(providerConf, http.MethodPatch, customAllocationRulesPath+"/"+d.Id(), payload, nil); err != nil {
+		return translateClientError(err, "error updating custom allocation rule")
+	}
+
+	return resourceDatadogCustomAllocationRuleRead(d, meta)
+}
+
+func resourceDatadogCustomAllocationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, customAllocationRulesPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting custom allocation rule")
+	}
+
+	return nil
+}
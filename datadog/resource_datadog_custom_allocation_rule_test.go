@@ -0,0 +1,69 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildCustomAllocationRulePayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogCustomAllocationRule().Schema, map[string]interface{}{
+		"rule_name": "shared-infra",
+		"costs_to_allocate": []interface{}{
+			map[string]interface{}{
+				"tag":       "service",
+				"condition": "is",
+				"values":    []interface{}{"kafka"},
+			},
+		},
+		"strategy": []interface{}{
+			map[string]interface{}{
+				"method":                "proportional",
+				"allocated_by_tag_keys": []interface{}{"team"},
+				"based_on_costs": []interface{}{
+					map[string]interface{}{
+						"tag":       "team",
+						"condition": "in",
+						"values":    []interface{}{"sre", "platform"},
+					},
+				},
+			},
+		},
+	})
+
+	payload := buildCustomAllocationRulePayload(d)
+	data := payload["data"].(map[string]interface{})
+	if data["type"] != "custom_allocation_rule" {
+		t.Errorf("unexpected payload type %v", data["type"])
+	}
+	attributes := data["attributes"].(map[string]interface{})
+	if attributes["rule_name"] != "shared-infra" || attributes["enabled"] != true {
+		t.Errorf("unexpected attributes: %v", attributes)
+	}
+	costs := attributes["costs_to_allocate"].([]map[string]interface{})
+	if len(costs) != 1 || costs[0]["tag"] != "service" || !reflect.DeepEqual(costs[0]["values"], []string{"kafka"}) {
+		t.Errorf("unexpected costs_to_allocate: %v", costs)
+	}
+	strategy := attributes["strategy"].(map[string]interface{})
+	if strategy["method"] != "proportional" || strategy["granularity"] != "daily" {
+		t.Errorf("unexpected strategy: %v", strategy)
+	}
+	basedOn := strategy["based_on_costs"].([]map[string]interface{})
+	if len(basedOn) != 1 || !reflect.DeepEqual(basedOn[0]["values"], []string{"sre", "platform"}) {
+		t.Errorf("unexpected based_on_costs: %v", basedOn)
+	}
+}
+
+func TestFlattenCustomAllocationRuleFilters(t *testing.T) {
+	flattened := flattenCustomAllocationRuleFilters([]interface{}{
+		map[string]interface{}{
+			"tag":       "service",
+			"condition": "is",
+			"values":    []interface{}{"kafka"},
+		},
+	})
+	if len(flattened) != 1 || flattened[0]["tag"] != "service" || flattened[0]["condition"] != "is" {
+		t.Errorf("unexpected flattened filters: %v", flattened)
+	}
+}
@@ -1,15 +1,33 @@
 package datadog
 
 import (
+	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
 	"strconv"
+	"strings"
+	"time"
 
 	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
 	datadogV2 "github.com/DataDog/datadog-api-client-go/api/v2/datadog"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-datadog/datadog/internal/widgets"
+)
+
+// Retry budget for dashboard reads, which can take a while on multi-MB
+// dashboards.
+const (
+	dashboardReadMaxRetries    = 3
+	dashboardReadRetryInterval = 5 * time.Second
 )
 
 func resourceDatadogDashboard() *schema.Resource {
@@ -20,13 +38,15 @@ func resourceDatadogDashboard() *schema.Resource {
 		Read:        resourceDatadogDashboardRead,
 		Delete:      resourceDatadogDashboardDelete,
 		CustomizeDiff: func(diff *schema.ResourceDiff, meta interface{}) error {
-			old, new := diff.GetChange("dashboard_lists")
-			if !old.(*schema.Set).Equal(new.(*schema.Set)) {
-				// Only calculate removed when the list change, to no create useless diffs
-				removed := old.(*schema.Set).Difference(new.(*schema.Set))
-				diff.SetNew("dashboard_lists_removed", removed)
-			} else {
-				diff.Clear("dashboard_lists_removed")
+			if err := validateDashboardWidgets(diff, meta); err != nil {
+				return err
+			}
+
+			if terraformWidgets, ok := diff.Get("widget").([]interface{}); ok {
+				diff.SetNew("widget_count", countDashboardWidgets(terraformWidgets))
+				if marshaled, err := json.Marshal(terraformWidgets); err == nil {
+					diff.SetNew("payload_size", len(marshaled))
+				}
 			}
 
 			return nil
@@ -34,6 +54,8 @@ func resourceDatadogDashboard() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceDatadogDashboardImport,
 		},
+		SchemaVersion: 1,
+		MigrateState:  resourceDatadogDashboardMigrateState,
 		Schema: map[string]*schema.Schema{
 			"title": {
 				Type:        schema.TypeString,
@@ -66,12 +88,33 @@ func resourceDatadogDashboard() *schema.Resource {
 				Default:     false,
 				Description: "Whether this dashboard is read-only.",
 			},
+			"managed_by_banner": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "When set, a 'Managed by Terraform' banner carrying this text (e.g. the workspace or module path) is appended to the dashboard description on create and update, discouraging UI edits of code-owned boards. The banner is stripped again when reading, so it never dirties plans.",
+			},
 			"url": {
 				Type:        schema.TypeString,
 				Optional:    true,
 				Computed:    true,
 				Description: "The URL of the dashboard.",
 			},
+			"widget_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of server-assigned IDs of the top level widgets, in the same order as the `widget` blocks.",
+				Elem:        &schema.Schema{Type: schema.TypeInt},
+			},
+			"widget_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of widgets on the dashboard, including widgets nested in groups. Computed at plan time so policy checks can forbid dashboards over org limits before the API rejects them.",
+			},
+			"payload_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Approximate size in bytes of the widget payload. Computed at plan time so policy checks can forbid dashboards over org limits before the API rejects them.",
+			},
 			"template_variable": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -100,16 +143,76 @@ func resourceDatadogDashboard() *schema.Resource {
 				Description: "The list of dashboard lists this dashboard belongs to.",
 				Elem:        &schema.Schema{Type: schema.TypeInt},
 			},
-			"dashboard_lists_removed": {
-				Type:        schema.TypeSet,
+			"verify": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Whether to fetch the dashboard after create and update and fail the apply if its JSON differs semantically from the applied configuration, catching silent API-side coercions early.",
+			},
+			"widget_query_overrides": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Experimental. A map of placeholder names to values substituted into the widgets when the dashboard is applied. Placeholders are written as `{{name}}` inside widget fields, so a single module can stamp per-service dashboards from one template.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"unknown_widget_handling": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "error",
+				ValidateFunc: validation.StringInSlice([]string{"error", "warn", "ignore"}, false),
+				Description:  "How widgets whose type the provider does not support are handled on read. `error` fails the refresh, `warn` logs a warning and preserves their raw JSON in `unknown_widget_json` so updates re-apply them, `ignore` drops them from state (and from the dashboard on the next update).",
+			},
+			"unknown_widget_json": {
+				Type:        schema.TypeList,
 				Computed:    true,
-				Description: "The list of dashboard lists this dashboard should be removed from. Internal only.",
-				Elem:        &schema.Schema{Type: schema.TypeInt},
+				Description: "The raw JSON of the top level widgets whose type the provider does not support, preserved when `unknown_widget_handling` is `warn`. Preserved widgets are re-appended at the end of the dashboard on update.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"preserve_unmodeled_fields": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Record the widget definition fields the provider does not model yet in `unmodeled_widget_json` on read, and merge them back on update instead of stripping them, so options configured in the UI survive an apply. Requires an extra API call per read.",
+			},
+			"unmodeled_widget_json": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "One JSON object per top level widget holding the definition fields the provider does not model yet, recorded when `preserve_unmodeled_fields` is set.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 		},
 	}
 }
 
+// resourceDatadogDashboardMigrateState migrates dashboard states written before
+// the group_by `sort` attribute was converted from a map to a nested block. The
+// flatmap keys `...group_by.N.sort.aggregation` become `...group_by.N.sort.0.aggregation`
+// and the map count key `sort.%` becomes the list count key `sort.#`.
+func resourceDatadogDashboardMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	if is.Empty() {
+		return is, nil
+	}
+	switch v {
+	case 0:
+		for k, value := range is.Attributes {
+			idx := strings.Index(k, ".sort.")
+			if idx == -1 || !strings.Contains(k, ".group_by.") {
+				continue
+			}
+			suffix := k[idx+len(".sort."):]
+			delete(is.Attributes, k)
+			if suffix == "%" {
+				is.Attributes[k[:idx]+".sort.#"] = "1"
+			} else {
+				is.Attributes[k[:idx]+".sort.0."+suffix] = value
+			}
+		}
+		return is, nil
+	default:
+		return is, fmt.Errorf("unexpected schema version: %d", v)
+	}
+}
+
 func resourceDatadogDashboardCreate(d *schema.ResourceData, meta interface{}) error {
 	providerConf := meta.(*ProviderConfiguration)
 	datadogClientV1 := providerConf.DatadogClientV1
@@ -118,26 +221,64 @@ func resourceDatadogDashboardCreate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return fmt.Errorf("failed to parse resource configuration: %s", err.Error())
 	}
-	dashboard, _, err := datadogClientV1.DashboardsApi.CreateDashboard(authV1).Body(*dashboardPayload).Execute()
-	if err != nil {
-		return translateClientError(err, "error creating dashboard")
+	needsRawApi := dashboardNeedsRawApi(d.Get("widget").([]interface{}))
+	var dashboard datadogV1.Dashboard
+	var verifyPayload interface{} = dashboardPayload
+	if needsRawApi {
+		// Send the dashboard as raw JSON since the generated client cannot
+		// carry formula and function queries or extended event overlay options
+		rawPayload, err := dashboardWithFormulas(d, dashboardPayload)
+		if err != nil {
+			return fmt.Errorf("failed to serialize dashboard: %s", err.Error())
+		}
+		verifyPayload = rawPayload
+		if _, err := sendRequest(providerConf, http.MethodPost, "/api/v1/dashboard", rawPayload, &dashboard); err != nil {
+			return translateClientError(err, "error creating dashboard")
+		}
+	} else {
+		dashboard, _, err = datadogClientV1.DashboardsApi.CreateDashboard(authV1).Body(*dashboardPayload).Execute()
+		if err != nil {
+			return translateClientError(err, "error creating dashboard")
+		}
 	}
 	d.SetId(*dashboard.Id)
 
-	return resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+	if err := resource.Retry(d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
+		select {
+		case <-authV1.Done():
+			return resource.NonRetryableError(authV1.Err())
+		default:
+		}
 		getDashboard, httpResponse, err := datadogClientV1.DashboardsApi.GetDashboard(authV1, *dashboard.Id).Execute()
 		if err != nil {
-			if httpResponse.StatusCode == 404 {
+			if httpResponse != nil && httpResponse.StatusCode == 404 {
 				return resource.RetryableError(fmt.Errorf("Dashboard not created yet"))
 			}
+			if isTransientError(httpResponse, err) {
+				return resource.RetryableError(fmt.Errorf("error getting dashboard, retrying: %v", err))
+			}
 			return resource.NonRetryableError(err)
 		}
 
+		var rawDashboard map[string]interface{}
+		if needsRawApi {
+			if rawDashboard, _, err = getDashboardRaw(providerConf, *dashboard.Id); err != nil {
+				return resource.NonRetryableError(err)
+			}
+		}
+
 		// We only log the error, as failing to update the list shouldn't fail dashboard creation
 		updateDashboardLists(d, providerConf, *dashboard.Id)
 
-		return resource.NonRetryableError(loadDatadogDashboard(d, getDashboard))
-	})
+		return resource.NonRetryableError(loadDatadogDashboard(d, getDashboard, rawDashboard))
+	}); err != nil {
+		return err
+	}
+
+	if d.Get("verify").(bool) {
+		return verifyDashboard(providerConf, *dashboard.Id, verifyPayload)
+	}
+	return nil
 }
 
 func resourceDatadogDashboardUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -149,15 +290,131 @@ func resourceDatadogDashboardUpdate(d *schema.ResourceData, meta interface{}) er
 	if err != nil {
 		return fmt.Errorf("failed to parse resource configuration: %s", err.Error())
 	}
-	if _, _, err = datadogClientV1.DashboardsApi.UpdateDashboard(authV1, id).Body(*dashboard).Execute(); err != nil {
+	var verifyPayload interface{} = dashboard
+	var preservedWidgets []interface{}
+	if d.Get("unknown_widget_handling").(string) == "warn" {
+		preservedWidgets = d.Get("unknown_widget_json").([]interface{})
+	}
+	var unmodeledWidgets []interface{}
+	if d.Get("preserve_unmodeled_fields").(bool) {
+		for _, u := range d.Get("unmodeled_widget_json").([]interface{}) {
+			if s, ok := u.(string); ok && s != "" && s != "{}" {
+				unmodeledWidgets = d.Get("unmodeled_widget_json").([]interface{})
+				break
+			}
+		}
+	}
+	if dashboardNeedsRawApi(d.Get("widget").([]interface{})) || len(preservedWidgets) > 0 || len(unmodeledWidgets) > 0 {
+		// Send the dashboard as raw JSON since the generated client cannot
+		// carry formula and function queries or extended event overlay options
+		rawPayload, err := dashboardWithFormulas(d, dashboard)
+		if err != nil {
+			return fmt.Errorf("failed to serialize dashboard: %s", err.Error())
+		}
+		// Merge back the definition fields the provider does not model yet,
+		// so the update does not strip options configured in the UI.
+		mergeUnmodeledWidgetFields(rawPayload, unmodeledWidgets)
+		// Re-append the widgets whose type the provider does not support, so
+		// the update does not wipe them from the dashboard.
+		if widgets, ok := rawPayload["widgets"].([]interface{}); ok {
+			for _, preserved := range preservedWidgets {
+				var widget map[string]interface{}
+				if err := json.Unmarshal([]byte(preserved.(string)), &widget); err == nil {
+					widgets = append(widgets, widget)
+				}
+			}
+			rawPayload["widgets"] = widgets
+		}
+		verifyPayload = rawPayload
+		if _, err := sendRequest(providerConf, http.MethodPut, "/api/v1/dashboard/"+id, rawPayload, nil); err != nil {
+			return translateClientError(err, "error updating dashboard")
+		}
+	} else if _, _, err = datadogClientV1.DashboardsApi.UpdateDashboard(authV1, id).Body(*dashboard).Execute(); err != nil {
 		return translateClientError(err, "error updating dashboard")
 	}
 
 	updateDashboardLists(d, providerConf, *dashboard.Id)
 
+	if d.Get("verify").(bool) {
+		if err := verifyDashboard(providerConf, id, verifyPayload); err != nil {
+			return err
+		}
+	}
+
 	return resourceDatadogDashboardRead(d, meta)
 }
 
+// verifyDashboard fetches a dashboard back after an apply and checks that the
+// payload that was sent is semantically contained in what the API stored.
+// Fields the API adds on its own (widget ids, author, timestamps, ...) are
+// ignored; values it dropped or coerced produce an error.
+func verifyDashboard(providerConf *ProviderConfiguration, id string, payload interface{}) error {
+	raw, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to serialize dashboard for verification: %s", err.Error())
+	}
+	var intended interface{}
+	if err := json.Unmarshal(raw, &intended); err != nil {
+		return fmt.Errorf("failed to serialize dashboard for verification: %s", err.Error())
+	}
+	actual, _, err := getDashboardRaw(providerConf, id)
+	if err != nil {
+		return translateClientError(err, "error verifying dashboard")
+	}
+	if diff := dashboardJSONDiff("", intended, actual); diff != "" {
+		return fmt.Errorf("dashboard %s differs from the applied configuration: %s", id, diff)
+	}
+	return nil
+}
+
+// dashboardJSONDiff returns a description of the first difference between the
+// intended JSON value and the actual one, or an empty string if the intended
+// value is semantically contained in the actual one. Nil and empty collections
+// in the intended value are skipped since the API omits them.
+func dashboardJSONDiff(path string, intended, actual interface{}) string {
+	switch intendedValue := intended.(type) {
+	case nil:
+		return ""
+	case map[string]interface{}:
+		actualValue, ok := actual.(map[string]interface{})
+		if !ok {
+			return fmt.Sprintf("%s: expected an object, got %v", path, actual)
+		}
+		for k, v := range intendedValue {
+			if v == nil {
+				continue
+			}
+			if m, ok := v.(map[string]interface{}); ok && len(m) == 0 {
+				continue
+			}
+			if l, ok := v.([]interface{}); ok && len(l) == 0 {
+				continue
+			}
+			if diff := dashboardJSONDiff(path+"/"+k, v, actualValue[k]); diff != "" {
+				return diff
+			}
+		}
+	case []interface{}:
+		actualValue, ok := actual.([]interface{})
+		if !ok {
+			return fmt.Sprintf("%s: expected an array, got %v", path, actual)
+		}
+		if len(intendedValue) != len(actualValue) {
+			return fmt.Sprintf("%s: expected %d elements, got %d", path, len(intendedValue), len(actualValue))
+		}
+		for i, v := range intendedValue {
+			if diff := dashboardJSONDiff(fmt.Sprintf("%s/%d", path, i), v, actualValue[i]); diff != "" {
+				return diff
+			}
+		}
+	default:
+		if !reflect.DeepEqual(intended, actual) {
+			return fmt.Sprintf("%s: expected %v, got %v", path, intended, actual)
+		}
+	}
+	return ""
+}
+
 func updateDashboardLists(d *schema.ResourceData, providerConf *ProviderConfiguration, dashboardId string) {
 	dashTypeString := "custom_screenboard"
 	if d.Get("layout_type").(string) == "ordered" {
@@ -168,39 +425,45 @@ func updateDashboardLists(d *schema.ResourceData, providerConf *ProviderConfigur
 	datadogClientV2 := providerConf.DatadogClientV2
 	authV2 := providerConf.AuthV2
 
-	if v, ok := d.GetOk("dashboard_lists"); ok && v.(*schema.Set).Len() > 0 {
+	// Memberships to drop are derived from the state transition directly, so
+	// no scratch attribute is needed to carry them between plan and apply.
+	oldValue, newValue := d.GetChange("dashboard_lists")
+	newLists := newValue.(*schema.Set)
+	removedLists := oldValue.(*schema.Set).Difference(newLists)
+
+	if newLists.Len() > 0 {
 		items := datadogV2.NewDashboardListAddItemsRequest()
 		items.SetDashboards(itemsRequest)
 
-		for _, id := range v.(*schema.Set).List() {
+		for _, id := range newLists.List() {
 			_, _, err := datadogClientV2.DashboardListsApi.CreateDashboardListItems(authV2, int64(id.(int))).Body(*items).Execute()
 			if err != nil {
-				log.Printf("[DEBUG] Got error adding to dashboard list %d: %v", id.(int), err)
+				providerConf.appendWarning("datadog_dashboard", "error adding dashboard %s to dashboard list %d: %v", dashboardId, id.(int), err)
 			}
 		}
 	}
 
-	if v, ok := d.GetOk("dashboard_lists_removed"); ok && v.(*schema.Set).Len() > 0 {
+	if removedLists.Len() > 0 {
 		items := datadogV2.NewDashboardListDeleteItemsRequest()
 		items.SetDashboards(itemsRequest)
 
-		for _, id := range v.(*schema.Set).List() {
+		for _, id := range removedLists.List() {
 			_, _, err := datadogClientV2.DashboardListsApi.DeleteDashboardListItems(authV2, int64(id.(int))).Body(*items).Execute()
 			if err != nil {
-				log.Printf("[DEBUG] Got error removing from dashboard list %d: %v", id.(int), err)
+				providerConf.appendWarning("datadog_dashboard", "error removing dashboard %s from dashboard list %d: %v", dashboardId, id.(int), err)
 			}
 		}
 	}
 }
 
-func loadDatadogDashboard(d *schema.ResourceData, dashboard datadogV1.Dashboard) error {
+func loadDatadogDashboard(d *schema.ResourceData, dashboard datadogV1.Dashboard, rawDashboard map[string]interface{}) error {
 	if err := d.Set("title", dashboard.GetTitle()); err != nil {
 		return err
 	}
 	if err := d.Set("layout_type", dashboard.GetLayoutType()); err != nil {
 		return err
 	}
-	if err := d.Set("description", dashboard.GetDescription()); err != nil {
+	if err := d.Set("description", stripDashboardBanner(dashboard.GetDescription())); err != nil {
 		return err
 	}
 	if err := d.Set("is_read_only", dashboard.GetIsReadOnly()); err != nil {
@@ -211,14 +474,72 @@ func loadDatadogDashboard(d *schema.ResourceData, dashboard datadogV1.Dashboard)
 	}
 
 	// Set widgets
-	terraformWidgets, err := buildTerraformWidgets(&dashboard.Widgets)
+	var rawWidgets []interface{}
+	if rawDashboard != nil {
+		rawWidgets, _ = rawDashboard["widgets"].([]interface{})
+	}
+	terraformWidgets, unknownWidgets, err := buildTerraformWidgets(&dashboard.Widgets, d.Get("unknown_widget_handling").(string), rawWidgets)
 	if err != nil {
 		return err
 	}
+	if err := d.Set("unknown_widget_json", unknownWidgets); err != nil {
+		return err
+	}
+	unmodeledWidgets := []string{}
+	if d.Get("preserve_unmodeled_fields").(bool) && len(rawWidgets) == len(dashboard.Widgets) {
+		unmodeledWidgets = buildUnmodeledWidgetFields(dashboard.Widgets, rawWidgets)
+	}
+	if err := d.Set("unmodeled_widget_json", unmodeledWidgets); err != nil {
+		return err
+	}
+	// Load back the formula and function queries and extended event overlay
+	// options the generated client dropped. The raw widgets are walked in
+	// parallel, so skip when unsupported widgets were dropped and the two
+	// lists no longer line up.
+	if len(rawWidgets) == len(*terraformWidgets) {
+		extractDashboardFormulas(*terraformWidgets, rawWidgets)
+		extractDashboardEventOverlays(*terraformWidgets, rawWidgets)
+	}
+	if v, ok := d.GetOk("widget_query_overrides"); ok {
+		// Turn override values back into their placeholders so the state
+		// matches the configured template. The prior state's widgets record
+		// where the placeholders were substituted.
+		if overrides := v.(map[string]interface{}); len(overrides) > 0 {
+			reverted, err := revertWidgetQueryOverrides(terraformWidgets, d.Get("widget").([]interface{}), overrides)
+			if err != nil {
+				return err
+			}
+			terraformWidgets = reverted
+		}
+	}
 	if err := d.Set("widget", terraformWidgets); err != nil {
 		return err
 	}
 
+	// Expose the server-assigned widget IDs so external automation can
+	// reference individual widgets from the state.
+	widgetIds := make([]int64, len(dashboard.Widgets))
+	for i, widget := range dashboard.Widgets {
+		widgetIds[i] = widget.GetId()
+	}
+	if err := d.Set("widget_ids", widgetIds); err != nil {
+		return err
+	}
+
+	// Recompute the policy check metrics from the normalized state, so they
+	// match the values planned in CustomizeDiff.
+	genericWidgets, _ := d.Get("widget").([]interface{})
+	if err := d.Set("widget_count", countDashboardWidgets(genericWidgets)); err != nil {
+		return err
+	}
+	marshaledWidgets, err := json.Marshal(genericWidgets)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("payload_size", len(marshaledWidgets)); err != nil {
+		return err
+	}
+
 	// Set template variables
 	templateVariables := buildTerraformTemplateVariables(&dashboard.TemplateVariables)
 	if err := d.Set("template_variable", templateVariables); err != nil {
@@ -245,7 +566,20 @@ func resourceDatadogDashboardRead(d *schema.ResourceData, meta interface{}) erro
 	datadogClientV1 := providerConf.DatadogClientV1
 	authV1 := providerConf.AuthV1
 	id := d.Id()
-	dashboard, httpresp, err := datadogClientV1.DashboardsApi.GetDashboard(authV1, id).Execute()
+	var dashboard datadogV1.Dashboard
+	var httpresp *http.Response
+	var err error
+	// Very large dashboards occasionally hit gateway timeouts; retry
+	// transient failures with a short backoff before giving up.
+	for attempt := 0; attempt < dashboardReadMaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * dashboardReadRetryInterval)
+		}
+		dashboard, httpresp, err = datadogClientV1.DashboardsApi.GetDashboard(authV1, id).Execute()
+		if !isTransientError(httpresp, err) {
+			break
+		}
+	}
 	if err != nil {
 		if httpresp != nil && httpresp.StatusCode == 404 {
 			d.SetId("")
@@ -254,7 +588,22 @@ func resourceDatadogDashboardRead(d *schema.ResourceData, meta interface{}) erro
 		return translateClientError(err, "error getting dashboard")
 	}
 
-	return loadDatadogDashboard(d, dashboard)
+	var rawDashboard map[string]interface{}
+	if dashboardNeedsRawApi(d.Get("widget").([]interface{})) || d.Get("unknown_widget_handling").(string) == "warn" || d.Get("preserve_unmodeled_fields").(bool) {
+		for attempt := 0; attempt < dashboardReadMaxRetries; attempt++ {
+			if attempt > 0 {
+				time.Sleep(time.Duration(attempt) * dashboardReadRetryInterval)
+			}
+			if rawDashboard, httpresp, err = getDashboardRaw(providerConf, id); !isTransientError(httpresp, err) {
+				break
+			}
+		}
+		if err != nil {
+			return translateClientError(err, "error getting dashboard")
+		}
+	}
+
+	return loadDatadogDashboard(d, dashboard, rawDashboard)
 }
 
 func resourceDatadogDashboardDelete(d *schema.ResourceData, meta interface{}) error {
@@ -269,12 +618,30 @@ func resourceDatadogDashboardDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceDatadogDashboardImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Purely numeric IDs belong to legacy screenboards/timeboards; the legacy
+	// endpoint knows the ID of the dashboard that replaced them.
+	if dashboardNumericIDRegexp.MatchString(d.Id()) {
+		providerConf := meta.(*ProviderConfiguration)
+		legacyDashboard, err := providerConf.CommunityClient.GetDashboard(d.Id())
+		if err != nil {
+			return nil, translateClientError(err, "error getting legacy dashboard")
+		}
+		newID := legacyDashboard.GetNewId()
+		if newID == "" {
+			return nil, fmt.Errorf("legacy dashboard %s has no modern dashboard equivalent; import it as a datadog_screenboard or datadog_timeboard resource instead, or use the string ID shown in the dashboard URL", d.Id())
+		}
+		d.SetId(newID)
+	}
 	if err := resourceDatadogDashboardRead(d, meta); err != nil {
 		return nil, err
 	}
 	return []*schema.ResourceData{d}, nil
 }
 
+// dashboardNumericIDRegexp matches the numeric IDs of legacy boards; modern
+// dashboard IDs are alphanumeric triplets such as abc-def-ghi.
+var dashboardNumericIDRegexp = regexp.MustCompile(`^[0-9]+$`)
+
 func buildDatadogDashboard(d *schema.ResourceData) (*datadogV1.Dashboard, error) {
 	var dashboard datadogV1.Dashboard
 
@@ -289,6 +656,9 @@ func buildDatadogDashboard(d *schema.ResourceData) (*datadogV1.Dashboard, error)
 	if v, ok := d.GetOk("description"); ok {
 		dashboard.SetDescription(v.(string))
 	}
+	if v, ok := d.GetOk("managed_by_banner"); ok {
+		dashboard.SetDescription(appendDashboardBanner(dashboard.GetDescription(), v.(string)))
+	}
 	if v, ok := d.GetOk("is_read_only"); ok {
 		dashboard.SetIsReadOnly(v.(bool))
 	}
@@ -301,6 +671,15 @@ func buildDatadogDashboard(d *schema.ResourceData) (*datadogV1.Dashboard, error)
 	}
 	dashboard.SetWidgets(*datadogWidgets)
 
+	// Apply widget query overrides
+	if v, ok := d.GetOk("widget_query_overrides"); ok {
+		if overrides := v.(map[string]interface{}); len(overrides) > 0 {
+			if err := applyWidgetQueryOverrides(&dashboard.Widgets, overrides); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	// Build NotifyList
 	notifyList := d.Get("notify_list").([]interface{})
 	dashboard.NotifyList = *buildDatadogNotifyList(&notifyList)
@@ -330,7 +709,10 @@ func getTemplateVariableSchema() map[string]*schema.Schema {
 		"prefix": {
 			Type:        schema.TypeString,
 			Optional:    true,
-			Description: "The tag prefix associated with the variable. Only tags with this prefix will appear in the variable dropdown.",
+			Description: "The tag prefix associated with the variable. Only tags with this prefix will appear in the variable dropdown. Several prefixes can be provided as a comma-separated list; their order does not matter.",
+			DiffSuppressFunc: func(k, oldVal, newVal string, d *schema.ResourceData) bool {
+				return normalizeTemplateVariablePrefix(oldVal) == normalizeTemplateVariablePrefix(newVal)
+			},
 		},
 		"default": {
 			Type:        schema.TypeString,
@@ -340,6 +722,20 @@ func getTemplateVariableSchema() map[string]*schema.Schema {
 	}
 }
 
+// normalizeTemplateVariablePrefix sorts the comma-separated prefixes of a
+// template variable, so the prefix list has set semantics and reordering it
+// doesn't dirty plans.
+func normalizeTemplateVariablePrefix(prefix string) string {
+	prefixes := []string{}
+	for _, p := range strings.Split(prefix, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			prefixes = append(prefixes, p)
+		}
+	}
+	sort.Strings(prefixes)
+	return strings.Join(prefixes, ",")
+}
+
 func buildDatadogTemplateVariables(terraformTemplateVariables *[]interface{}) *[]datadogV1.DashboardTemplateVariables {
 	datadogTemplateVariables := make([]datadogV1.DashboardTemplateVariables, len(*terraformTemplateVariables))
 	for i, ttv := range *terraformTemplateVariables {
@@ -349,7 +745,7 @@ func buildDatadogTemplateVariables(terraformTemplateVariables *[]interface{}) *[
 			datadogTemplateVariable.SetName(v)
 		}
 		if v, ok := terraformTemplateVariable["prefix"].(string); ok && len(v) != 0 {
-			datadogTemplateVariable.SetPrefix(v)
+			datadogTemplateVariable.SetPrefix(normalizeTemplateVariablePrefix(v))
 		}
 		if v, ok := terraformTemplateVariable["default"].(string); ok && len(v) != 0 {
 			datadogTemplateVariable.SetDefault(v)
@@ -833,7 +1229,11 @@ func buildDatadogWidget(terraformWidget map[string]interface{}) (*datadogV1.Widg
 		}
 	} else if def, ok := terraformWidget["note_definition"].([]interface{}); ok && len(def) > 0 {
 		if noteDefinition, ok := def[0].(map[string]interface{}); ok {
-			definition = datadogV1.NoteWidgetDefinitionAsWidgetDefinition(buildDatadogNoteDefinition(noteDefinition))
+			datadogDefinition, err := buildDatadogNoteDefinition(noteDefinition)
+			if err != nil {
+				return nil, err
+			}
+			definition = datadogV1.NoteWidgetDefinitionAsWidgetDefinition(datadogDefinition)
 		}
 	} else if def, ok := terraformWidget["query_value_definition"].([]interface{}); ok && len(def) > 0 {
 		if queryValueDefinition, ok := def[0].(map[string]interface{}); ok {
@@ -882,17 +1282,32 @@ func buildDatadogWidget(terraformWidget map[string]interface{}) (*datadogV1.Widg
 }
 
 // Helper to build a list of Terraform widgets from a list of Datadog widgets
-func buildTerraformWidgets(datadogWidgets *[]datadogV1.Widget) (*[]map[string]interface{}, error) {
+func buildTerraformWidgets(datadogWidgets *[]datadogV1.Widget, unknownHandling string, rawWidgets []interface{}) (*[]map[string]interface{}, []string, error) {
 
-	terraformWidgets := make([]map[string]interface{}, len(*datadogWidgets))
+	terraformWidgets := make([]map[string]interface{}, 0, len(*datadogWidgets))
+	unknownWidgets := []string{}
 	for i, datadogWidget := range *datadogWidgets {
 		terraformWidget, err := buildTerraformWidget(datadogWidget)
 		if err != nil {
-			return nil, err
+			switch unknownHandling {
+			case "ignore":
+				log.Printf("[DEBUG] dropping widget from state: %s", err.Error())
+				continue
+			case "warn":
+				log.Printf("[WARN] preserving raw JSON of widget in unknown_widget_json: %s", err.Error())
+				if i < len(rawWidgets) {
+					if encoded, err := json.Marshal(rawWidgets[i]); err == nil {
+						unknownWidgets = append(unknownWidgets, string(encoded))
+					}
+				}
+				continue
+			default:
+				return nil, nil, err
+			}
 		}
-		terraformWidgets[i] = terraformWidget
+		terraformWidgets = append(terraformWidgets, terraformWidget)
 	}
-	return &terraformWidgets, nil
+	return &terraformWidgets, unknownWidgets, nil
 }
 
 // Helper to build a Terraform widget from a Datadog widget
@@ -907,7 +1322,10 @@ func buildTerraformWidget(datadogWidget datadogV1.Widget) (map[string]interface{
 	// Build definition
 	widgetDefinition := datadogWidget.GetDefinition()
 	if widgetDefinition.GroupWidgetDefinition != nil {
-		terraformDefinition := buildTerraformGroupDefinition(*widgetDefinition.GroupWidgetDefinition)
+		terraformDefinition, err := buildTerraformGroupDefinition(*widgetDefinition.GroupWidgetDefinition)
+		if err != nil {
+			return nil, err
+		}
 		terraformWidget["group_definition"] = []map[string]interface{}{terraformDefinition}
 	} else if widgetDefinition.AlertGraphWidgetDefinition != nil {
 		terraformDefinition := buildTerraformAlertGraphDefinition(*widgetDefinition.AlertGraphWidgetDefinition)
@@ -1105,12 +1523,15 @@ func buildDatadogGroupDefinition(terraformGroupDefinition map[string]interface{}
 	return datadogGroupDefinition, nil
 }
 
-func buildTerraformGroupDefinition(datadogGroupDefinition datadogV1.GroupWidgetDefinition) map[string]interface{} {
+func buildTerraformGroupDefinition(datadogGroupDefinition datadogV1.GroupWidgetDefinition) (map[string]interface{}, error) {
 	terraformGroupDefinition := map[string]interface{}{}
 
 	var groupWidgets []map[string]interface{}
 	for _, datadogGroupWidgets := range datadogGroupDefinition.Widgets {
-		newGroupWidget, _ := buildTerraformWidget(datadogGroupWidgets)
+		newGroupWidget, err := buildTerraformWidget(datadogGroupWidgets)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert widget nested in group %q: %s", datadogGroupDefinition.GetTitle(), err.Error())
+		}
 		groupWidgets = append(groupWidgets, newGroupWidget)
 	}
 	terraformGroupDefinition["widget"] = groupWidgets
@@ -1122,7 +1543,7 @@ func buildTerraformGroupDefinition(datadogGroupDefinition datadogV1.GroupWidgetD
 		terraformGroupDefinition["title"] = v
 	}
 
-	return terraformGroupDefinition
+	return terraformGroupDefinition, nil
 }
 
 //
@@ -1132,9 +1553,12 @@ func buildTerraformGroupDefinition(datadogGroupDefinition datadogV1.GroupWidgetD
 func getAlertGraphDefinitionSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"alert_id": {
-			Description: "The ID of the monitor used by the widget.",
+			Description: "The ID of the monitor used by the widget. A monitor web URL is also accepted and normalized to its ID.",
 			Type:        schema.TypeString,
 			Required:    true,
+			StateFunc: func(val interface{}) string {
+				return normalizeMonitorReference(val.(string))
+			},
 		},
 		"viz_type": {
 			Description:  "Type of visualization to use when displaying the widget. Either `timeseries` or `toplist`.",
@@ -1218,9 +1642,12 @@ func buildTerraformAlertGraphDefinition(datadogDefinition datadogV1.AlertGraphWi
 func getAlertValueDefinitionSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"alert_id": {
-			Description: "The ID of the monitor used by the widget.",
+			Description: "The ID of the monitor used by the widget. A monitor web URL is also accepted and normalized to its ID.",
 			Type:        schema.TypeString,
 			Required:    true,
+			StateFunc: func(val interface{}) string {
+				return normalizeMonitorReference(val.(string))
+			},
 		},
 		"precision": {
 			Description: "The precision to use when displaying the value. Use `*` for maximum precision.",
@@ -1951,8 +2378,8 @@ func getCheckStatusDefinitionSchema() map[string]*schema.Schema {
 			Elem:        &schema.Schema{Type: schema.TypeString},
 		},
 		"tags": {
-			Description: "List of tags to use in the widget.",
-			Type:        schema.TypeList,
+			Description: "List of tags to use in the widget. The order of the tags is not significant.",
+			Type:        schema.TypeSet,
 			Optional:    true,
 			Elem:        &schema.Schema{Type: schema.TypeString},
 		},
@@ -1999,9 +2426,9 @@ func buildDatadogCheckStatusDefinition(terraformDefinition map[string]interface{
 		}
 		datadogDefinition.SetGroupBy(datadogGroupBys)
 	}
-	if terraformTags, ok := terraformDefinition["tags"].([]interface{}); ok && len(terraformTags) > 0 {
-		datadogTags := make([]string, len(terraformTags))
-		for i, tag := range terraformTags {
+	if terraformTags, ok := terraformDefinition["tags"].(*schema.Set); ok && terraformTags.Len() > 0 {
+		datadogTags := make([]string, terraformTags.Len())
+		for i, tag := range terraformTags.List() {
 			datadogTags[i] = tag.(string)
 		}
 		datadogDefinition.SetTags(datadogTags)
@@ -2426,9 +2853,10 @@ func getHostmapDefinitionSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"palette": {
-						Description: "Color palette to apply to the widget. The available options are available here: https://docs.datadoghq.com/dashboards/widgets/timeseries/#appearance.",
-						Type:        schema.TypeString,
-						Optional:    true,
+						Description:  "Color palette to apply to the widget. The available options are available here: https://docs.datadoghq.com/dashboards/widgets/timeseries/#appearance.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateWidgetPalette,
 					},
 					"palette_flip": {
 						Description: "Boolean indicating whether to flip the palette tones.",
@@ -2596,7 +3024,9 @@ func getHostmapRequestSchema() map[string]*schema.Schema {
 		// A request should implement at least one of the following type of query
 		"q":              getMetricQuerySchema(),
 		"apm_query":      getApmLogNetworkRumSecurityQuerySchema(),
+		"event_query":    getApmLogNetworkRumSecurityQuerySchema(),
 		"log_query":      getApmLogNetworkRumSecurityQuerySchema(),
+		"network_query":  getApmLogNetworkRumSecurityQuerySchema(),
 		"process_query":  getProcessQuerySchema(),
 		"rum_query":      getApmLogNetworkRumSecurityQuerySchema(),
 		"security_query": getApmLogNetworkRumSecurityQuerySchema(),
@@ -2610,9 +3040,15 @@ func buildDatadogHostmapRequest(terraformRequest map[string]interface{}) *datado
 	} else if v, ok := terraformRequest["apm_query"].([]interface{}); ok && len(v) > 0 {
 		apmQuery := v[0].(map[string]interface{})
 		datadogHostmapRequest.ApmQuery = buildDatadogApmOrLogQuery(apmQuery)
+	} else if v, ok := terraformRequest["event_query"].([]interface{}); ok && len(v) > 0 {
+		eventQuery := v[0].(map[string]interface{})
+		datadogHostmapRequest.EventQuery = buildDatadogApmOrLogQuery(eventQuery)
 	} else if v, ok := terraformRequest["log_query"].([]interface{}); ok && len(v) > 0 {
 		logQuery := v[0].(map[string]interface{})
 		datadogHostmapRequest.LogQuery = buildDatadogApmOrLogQuery(logQuery)
+	} else if v, ok := terraformRequest["network_query"].([]interface{}); ok && len(v) > 0 {
+		networkQuery := v[0].(map[string]interface{})
+		datadogHostmapRequest.NetworkQuery = buildDatadogApmOrLogQuery(networkQuery)
 	} else if v, ok := terraformRequest["process_query"].([]interface{}); ok && len(v) > 0 {
 		processQuery := v[0].(map[string]interface{})
 		datadogHostmapRequest.ProcessQuery = buildDatadogProcessQuery(processQuery)
@@ -2633,9 +3069,15 @@ func buildTerraformHostmapRequest(datadogHostmapRequest *datadogV1.HostMapReques
 	} else if v, ok := datadogHostmapRequest.GetApmQueryOk(); ok {
 		terraformQuery := buildTerraformApmOrLogQuery(*v)
 		terraformRequest["apm_query"] = []map[string]interface{}{terraformQuery}
+	} else if v, ok := datadogHostmapRequest.GetEventQueryOk(); ok {
+		terraformQuery := buildTerraformApmOrLogQuery(*v)
+		terraformRequest["event_query"] = []map[string]interface{}{terraformQuery}
 	} else if v, ok := datadogHostmapRequest.GetLogQueryOk(); ok {
 		terraformQuery := buildTerraformApmOrLogQuery(*v)
 		terraformRequest["log_query"] = []map[string]interface{}{terraformQuery}
+	} else if v, ok := datadogHostmapRequest.GetNetworkQueryOk(); ok {
+		terraformQuery := buildTerraformApmOrLogQuery(*v)
+		terraformRequest["network_query"] = []map[string]interface{}{terraformQuery}
 	} else if v, ok := datadogHostmapRequest.GetProcessQueryOk(); ok {
 		terraformQuery := buildTerraformProcessQuery(*v)
 		terraformRequest["process_query"] = []map[string]interface{}{terraformQuery}
@@ -2656,9 +3098,10 @@ func buildTerraformHostmapRequest(datadogHostmapRequest *datadogV1.HostMapReques
 func getIframeDefinitionSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"url": {
-			Description: "The URL to use as a data source for the widget.",
-			Type:        schema.TypeString,
-			Required:    true,
+			Description:  "The URL to use as a data source for the widget. Template variable interpolation markers (eg. `$var.value`) are passed through without escaping.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateWidgetURL,
 		},
 	}
 }
@@ -3118,10 +3561,11 @@ func buildTerraformManageStatusDefinition(datadogDefinition datadogV1.MonitorSum
 func getNoteDefinitionSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"content": {
-			Description:  "Content of the note.",
-			Type:         schema.TypeString,
-			Required:     true,
-			ValidateFunc: validation.StringIsNotEmpty,
+			Description:      "Content of the note.",
+			Type:             schema.TypeString,
+			Required:         true,
+			ValidateFunc:     validation.StringIsNotEmpty,
+			DiffSuppressFunc: suppressNoteContentDiff,
 		},
 		"background_color": {
 			Description: "Background color of the note.",
@@ -3158,54 +3602,31 @@ func getNoteDefinitionSchema() map[string]*schema.Schema {
 	}
 }
 
-func buildDatadogNoteDefinition(terraformDefinition map[string]interface{}) *datadogV1.NoteWidgetDefinition {
-	datadogDefinition := datadogV1.NewNoteWidgetDefinitionWithDefaults()
-	// Required params
-	datadogDefinition.SetContent(terraformDefinition["content"].(string))
-	// Optional params
-	if v, ok := terraformDefinition["background_color"].(string); ok && len(v) != 0 {
-		datadogDefinition.SetBackgroundColor(v)
-	}
-	if v, ok := terraformDefinition["font_size"].(string); ok && len(v) != 0 {
-		datadogDefinition.SetFontSize(v)
-	}
-	if v, ok := terraformDefinition["text_align"].(string); ok && len(v) != 0 {
-		datadogDefinition.SetTextAlign(datadogV1.WidgetTextAlign(v))
-	}
-	if v, ok := terraformDefinition["show_tick"]; ok {
-		datadogDefinition.SetShowTick(v.(bool))
-	}
-	if v, ok := terraformDefinition["tick_pos"].(string); ok && len(v) != 0 {
-		datadogDefinition.SetTickPos(v)
-	}
-	if v, ok := terraformDefinition["tick_edge"].(string); ok && len(v) != 0 {
-		datadogDefinition.SetTickEdge(datadogV1.WidgetTickEdge(v))
+// suppressNoteContentDiff ignores line ending differences in note content:
+// bodies loaded with file()/templatefile() typically carry a trailing newline
+// (or CRLF endings, when authored on Windows) that the API strips, which
+// would otherwise generate a perpetual diff.
+func suppressNoteContentDiff(k, oldVal, newVal string, d *schema.ResourceData) bool {
+	return normalizeNoteContent(oldVal) == normalizeNoteContent(newVal)
+}
+
+func normalizeNoteContent(content string) string {
+	return strings.TrimRight(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+}
+
+func buildDatadogNoteDefinition(terraformDefinition map[string]interface{}) (*datadogV1.NoteWidgetDefinition, error) {
+	datadogDefinition, err := widgets.BuildNote(terraformDefinition)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build note widget definition: %s", err.Error())
 	}
-	return datadogDefinition
+	return datadogDefinition, nil
 }
 
 func buildTerraformNoteDefinition(datadogDefinition datadogV1.NoteWidgetDefinition) map[string]interface{} {
-	terraformDefinition := map[string]interface{}{}
-	// Required params
-	terraformDefinition["content"] = datadogDefinition.GetContent()
-	// Optional params
-	if v, ok := datadogDefinition.GetBackgroundColorOk(); ok {
-		terraformDefinition["background_color"] = *v
-	}
-	if v, ok := datadogDefinition.GetFontSizeOk(); ok {
-		terraformDefinition["font_size"] = *v
-	}
-	if v, ok := datadogDefinition.GetTextAlignOk(); ok {
-		terraformDefinition["text_align"] = *v
-	}
-	if v, ok := datadogDefinition.GetShowTickOk(); ok {
-		terraformDefinition["show_tick"] = *v
-	}
-	if v, ok := datadogDefinition.GetTickPosOk(); ok {
-		terraformDefinition["tick_pos"] = *v
-	}
-	if v, ok := datadogDefinition.GetTickEdgeOk(); ok {
-		terraformDefinition["tick_edge"] = *v
+	terraformDefinition, err := widgets.FlattenNote(datadogDefinition)
+	if err != nil {
+		log.Printf("[DEBUG] failed to encode note widget definition: %s", err.Error())
+		return map[string]interface{}{}
 	}
 	return terraformDefinition
 }
@@ -3358,7 +3779,9 @@ func getQueryValueRequestSchema() map[string]*schema.Schema {
 		"process_query":  getProcessQuerySchema(),
 		"rum_query":      getApmLogNetworkRumSecurityQuerySchema(),
 		"security_query": getApmLogNetworkRumSecurityQuerySchema(),
+		"query":          getFormulaQuerySchema(),
 		// Settings specific to QueryValue requests
+		"formula": getFormulaSchema(),
 		"conditional_formats": {
 			Description: "Conditional formats allow you to set the color of your widget content or background, depending on a rule applied to your data. Multiple `conditional_formats` blocks are allowed with the structure below.",
 			Type:        schema.TypeList,
@@ -3562,7 +3985,9 @@ func getQueryTableRequestSchema() map[string]*schema.Schema {
 		"rum_query":       getApmLogNetworkRumSecurityQuerySchema(),
 		"security_query":  getApmLogNetworkRumSecurityQuerySchema(),
 		"apm_stats_query": getApmStatsQuerySchema(),
+		"query":           getFormulaQuerySchema(),
 		// Settings specific to QueryTable requests
+		"formula": getFormulaSchema(),
 		"conditional_formats": {
 			Description: "Conditional formats allow you to set the color of your widget content or background, depending on a rule applied to your data. Multiple `conditional_formats` blocks are allowed with the structure below.",
 			Type:        schema.TypeList,
@@ -4100,9 +4525,12 @@ func getServiceLevelObjectiveDefinitionSchema() map[string]*schema.Schema {
 			Required:    true,
 		},
 		"slo_id": {
-			Description: "The ID of the service level objective used by the widget.",
+			Description: "The ID of the service level objective used by the widget. An SLO web URL is also accepted and normalized to its ID.",
 			Type:        schema.TypeString,
 			Required:    true,
+			StateFunc: func(val interface{}) string {
+				return normalizeSLOReference(val.(string))
+			},
 		},
 		"show_error_budget": {
 			Description: "Whether to show the error budget or not.",
@@ -4398,9 +4826,10 @@ func getTimeseriesRequestSchema() map[string]*schema.Schema {
 			Elem: &schema.Resource{
 				Schema: map[string]*schema.Schema{
 					"palette": {
-						Description: "Color palette to apply to the widget. The available options are available here: https://docs.datadoghq.com/dashboards/widgets/timeseries/#appearance.",
-						Type:        schema.TypeString,
-						Optional:    true,
+						Description:  "Color palette to apply to the widget. The available options are available here: https://docs.datadoghq.com/dashboards/widgets/timeseries/#appearance.",
+						Type:         schema.TypeString,
+						Optional:     true,
+						ValidateFunc: validateWidgetPalette,
 					},
 					"line_type": {
 						Description:  "Type of lines displayed. Available values are: `dashed`, `dotted`, or `solid`.",
@@ -4964,14 +5393,16 @@ func getWidgetConditionalFormatSchema() map[string]*schema.Schema {
 			Required:     true,
 		},
 		"custom_bg_color": {
-			Description: "Color palette to apply to the background, same values available as palette.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:  "Custom hex color to apply to the background, used with the `custom_bg` palette.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateHexColor,
 		},
 		"custom_fg_color": {
-			Description: "Color palette to apply to the foreground, same values available as palette.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:  "Custom hex color to apply to the foreground, used with the `custom_text` palette.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateHexColor,
 		},
 		"image_url": {
 			Description: "Displays an image as the background.",
@@ -4984,12 +5415,13 @@ func getWidgetConditionalFormatSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"timeframe": {
-			Description: "Defines the displayed timeframe.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:  "Defines the displayed timeframe. One of `10m`, `30m`, `1h`, `4h`, `1d`, `2d`, `1w`.",
+			Type:         schema.TypeString,
+			ValidateFunc: validateEnumValue(datadogV1.NewWidgetLiveSpanFromValue),
+			Optional:     true,
 		},
 		"metric": {
-			Description: "Metric from the request to correlate this conditional format with.",
+			Description: "Metric from the request to correlate this conditional format with. A pattern such as `system.cpu.*` matches every metric of a multi-query widget.",
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
@@ -5113,6 +5545,27 @@ func getWidgetEventSchema() map[string]*schema.Schema {
 			Type:        schema.TypeString,
 			Optional:    true,
 		},
+		"excluded_tags": {
+			Description: "A list of tags the matched events are filtered out on.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+		},
+		"style": {
+			Description: "Styling options for the event markers.",
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"palette": {
+						Description: "The color palette used to display the event markers, e.g. `purple` for deploy markers.",
+						Type:        schema.TypeString,
+						Optional:    true,
+					},
+				},
+			},
+		},
 	}
 }
 func buildDatadogWidgetEvents(terraformWidgetEvents *[]interface{}) *[]datadogV1.WidgetEvent {
@@ -5128,10 +5581,10 @@ func buildDatadogWidgetEvents(terraformWidgetEvents *[]interface{}) *[]datadogV1
 
 	return &datadogWidgetEvents
 }
-func buildTerraformWidgetEvents(datadogWidgetEvents *[]datadogV1.WidgetEvent) *[]map[string]string {
-	terraformWidgetEvents := make([]map[string]string, len(*datadogWidgetEvents))
+func buildTerraformWidgetEvents(datadogWidgetEvents *[]datadogV1.WidgetEvent) *[]map[string]interface{} {
+	terraformWidgetEvents := make([]map[string]interface{}, len(*datadogWidgetEvents))
 	for i, datadogWidget := range *datadogWidgetEvents {
-		terraformWidget := map[string]string{}
+		terraformWidget := map[string]interface{}{}
 		// Required params
 		terraformWidget["q"] = datadogWidget.GetQ()
 		// Optional params
@@ -5175,14 +5628,16 @@ func buildTerraformWidgetTime(datadogWidgetTime datadogV1.WidgetTime) map[string
 func getWidgetMarkerSchema() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"value": {
-			Description: "Mathematical expression describing the marker. Examples: `y > 1`, `-5 < y < 0`, `y = 19`.",
-			Type:        schema.TypeString,
-			Required:    true,
+			Description:  "Mathematical expression describing the marker. Examples: `y > 1`, `-5 < y < 0`, `y = 19`.",
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: validateMarkerValue,
 		},
 		"display_type": {
-			Description: "How the marker lines will look. Possible values are one of {`error`, `warning`, `info`, `ok`} combined with one of {`dashed`, `solid`, `bold`}. Example: `error dashed`.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:  "How the marker lines will look. Possible values are one of {`error`, `warning`, `info`, `ok`} combined with one of {`dashed`, `solid`, `bold`}. Example: `error dashed`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateMarkerDisplayType,
 		},
 		"label": {
 			Description: "A label for the line or range.",
@@ -5296,9 +5751,10 @@ func getApmLogNetworkRumSecurityQuerySchema() *schema.Schema {
 								Optional:    true,
 							},
 							"sort": {
-								Description: "One map is allowed with the keys as below.",
-								Type:        schema.TypeMap,
+								Description: "One nested block is allowed with the structure below describing how to sort the group results.",
+								Type:        schema.TypeList,
 								Optional:    true,
+								MaxItems:    1,
 								Elem: &schema.Resource{
 									Schema: map[string]*schema.Schema{
 										"aggregation": {
@@ -5374,7 +5830,9 @@ func buildDatadogApmOrLogQuery(terraformQuery map[string]interface{}) *datadogV1
 	// Multi-compute
 	terraformMultiCompute := terraformQuery["multi_compute"].([]interface{})
 	if len(terraformMultiCompute) > 0 {
-		// TODO: raise an error if compute is already set
+		// Setting both compute and multi_compute is rejected at plan time by
+		// validateComputeTree; multi_compute wins if this is ever reached
+		datadogQuery.Compute = nil
 		datadogComputeList := make([]datadogV1.LogsQueryCompute, len(terraformMultiCompute))
 		for i, terraformCompute := range terraformMultiCompute {
 			terraformComputeMap := terraformCompute.(map[string]interface{})
@@ -5410,8 +5868,8 @@ func buildDatadogApmOrLogQuery(terraformQuery map[string]interface{}) *datadogV1
 				datadogGroupBy.SetLimit(int64(v))
 			}
 			// Sort
-			if sort, ok := groupBy["sort"].(map[string]interface{}); ok && len(sort) > 0 {
-
+			if sorts, ok := groupBy["sort"].([]interface{}); ok && len(sorts) > 0 {
+				sort := sorts[0].(map[string]interface{})
 				datadogGroupBy.Sort = &datadogV1.LogQueryDefinitionSort{}
 				if aggr, ok := sort["aggregation"].(string); ok && len(aggr) > 0 {
 					datadogGroupBy.Sort.SetAggregation(aggr)
@@ -5490,7 +5948,7 @@ func buildTerraformApmOrLogQuery(datadogQuery datadogV1.LogQueryDefinition) map[
 				if groupBy.Sort.Facet != nil {
 					sort["facet"] = *groupBy.Sort.Facet
 				}
-				terraformGroupBy["sort"] = sort
+				terraformGroupBy["sort"] = []map[string]string{sort}
 			}
 
 			terraformGroupBys[i] = terraformGroupBy
@@ -5518,11 +5976,26 @@ func getProcessQuerySchema() *schema.Schema {
 					Description: "Your chosen search term.",
 					Type:        schema.TypeString,
 					Optional:    true,
+					Computed:    true,
 				},
-				"filter_by": {
+				"query_filter": {
+					Description: "The text to use as a filter. Alias for `search_by` matching the new process widget format, conflicts with `search_by`.",
+					Type:        schema.TypeString,
+					Optional:    true,
+					Computed:    true,
+				},
+				"filter_by": {
 					Description: "List of processes.",
 					Type:        schema.TypeList,
 					Optional:    true,
+					Computed:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"tag_filters": {
+					Description: "An array of tags to filter by. Alias for `filter_by` matching the new process widget format, conflicts with `filter_by`.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					Computed:    true,
 					Elem:        &schema.Schema{Type: schema.TypeString},
 				},
 				"limit": {
@@ -5541,9 +6014,15 @@ func buildDatadogProcessQuery(terraformQuery map[string]interface{}) *datadogV1.
 	}
 	if v, ok := terraformQuery["search_by"].(string); ok && len(v) != 0 {
 		datadogQuery.SetSearchBy(v)
+	} else if v, ok := terraformQuery["query_filter"].(string); ok && len(v) != 0 {
+		datadogQuery.SetSearchBy(v)
 	}
 
-	if terraformFilterBys, ok := terraformQuery["filter_by"].([]interface{}); ok && len(terraformFilterBys) > 0 {
+	terraformFilterBys, ok := terraformQuery["filter_by"].([]interface{})
+	if !ok || len(terraformFilterBys) == 0 {
+		terraformFilterBys, _ = terraformQuery["tag_filters"].([]interface{})
+	}
+	if len(terraformFilterBys) > 0 {
 		datadogFilterbys := make([]string, len(terraformFilterBys))
 		for i, filterBy := range terraformFilterBys {
 			datadogFilterbys[i] = filterBy.(string)
@@ -5564,7 +6043,10 @@ func buildTerraformProcessQuery(datadogQuery datadogV1.ProcessQueryDefinition) m
 		terraformQuery["metric"] = v
 	}
 	if v, ok := datadogQuery.GetSearchByOk(); ok {
+		// Mirror the value into both the legacy attribute and its alias so
+		// that configurations written against either do not produce a diff.
 		terraformQuery["search_by"] = v
+		terraformQuery["query_filter"] = v
 	}
 	if v, ok := datadogQuery.GetFilterByOk(); ok {
 		terraformFilterBys := make([]string, len(*v))
@@ -5572,6 +6054,7 @@ func buildTerraformProcessQuery(datadogQuery datadogV1.ProcessQueryDefinition) m
 			terraformFilterBys[i] = datadogFilterBy
 		}
 		terraformQuery["filter_by"] = terraformFilterBys
+		terraformQuery["tag_filters"] = terraformFilterBys
 	}
 	if v, ok := datadogQuery.GetLimitOk(); ok {
 		terraformQuery["limit"] = v
@@ -5604,14 +6087,17 @@ func getApmStatsQuerySchema() *schema.Schema {
 					Required:    true,
 				},
 				"primary_tag": {
-					Description: "The organization's host group name and value.",
-					Type:        schema.TypeString,
-					Required:    true,
+					Description:  "The organization's host group name and value.",
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validatePrimaryTag,
 				},
 				"row_type": {
-					Description:  "The level of detail for the request.",
-					Type:         schema.TypeString,
-					ValidateFunc: validateEnumValue(datadogV1.NewApmStatsQueryRowTypeFromValue),
+					Description: "The level of detail for the request. One of `service`, `resource`, `span` or `distributions`.",
+					Type:        schema.TypeString,
+					// The generated client enum does not know about
+					// `distributions` yet, validate against a local list.
+					ValidateFunc: validation.StringInSlice([]string{"service", "resource", "span", "distributions"}, false),
 					Required:     true,
 				},
 				"resource": {
@@ -5763,19 +6249,22 @@ func getWidgetAxisSchema() map[string]*schema.Schema {
 			Optional:    true,
 		},
 		"scale": {
-			Description: "Specifies the scale type. One of `linear`, `log`, `pow`, `sqrt`.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:  "Specifies the scale type. One of `linear`, `log`, `pow`, `sqrt`.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringInSlice([]string{"linear", "log", "pow", "sqrt"}, false),
 		},
 		"min": {
-			Description: "Specify the minimum value to show on the Y-axis.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:  "Specify the minimum value to show on the Y-axis. A number, or `auto` for default behavior.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateWidgetAxisValue,
 		},
 		"max": {
-			Description: "Specify the maximum value to show on the Y-axis.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:  "Specify the maximum value to show on the Y-axis. A number, or `auto` for default behavior.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateWidgetAxisValue,
 		},
 		"include_zero": {
 			Description: "Always include zero or fit the axis to the data range.",
@@ -5830,9 +6319,10 @@ func buildTerraformWidgetAxis(datadogWidgetAxis datadogV1.WidgetAxis) map[string
 func getWidgetRequestStyle() map[string]*schema.Schema {
 	return map[string]*schema.Schema{
 		"palette": {
-			Description: "Color palette to apply to the widget. The available options are available here: https://docs.datadoghq.com/dashboards/widgets/timeseries/#appearance.",
-			Type:        schema.TypeString,
-			Optional:    true,
+			Description:  "Color palette to apply to the widget. The available options are available here: https://docs.datadoghq.com/dashboards/widgets/timeseries/#appearance.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			ValidateFunc: validateWidgetPalette,
 		},
 	}
 }
@@ -5919,14 +6409,1777 @@ func buildTerraformHostmapRequestStyle(datadogStyle datadogV1.HostMapWidgetDefin
 }
 
 // Schema validation
+
+// widgetLegendSizes are the legend sizes accepted by the API, shared by the
+// timeseries, heatmap and distribution widgets. New values only need to be
+// added here.
+var widgetLegendSizes = []string{"0", "2", "4", "8", "16", "32", "64", "auto"}
+
 func validateTimeseriesWidgetLegendSize(val interface{}, key string) (warns []string, errs []error) {
 	value := val.(string)
-	switch value {
-	case "0", "2", "4", "8", "16", "auto":
-		break
-	default:
-		errs = append(errs, fmt.Errorf(
-			"%q contains an invalid value %q. Valid values are `0`, `2`, `4`, `8`, `16`, or `auto`", key, value))
+	for _, size := range widgetLegendSizes {
+		if value == size {
+			return
+		}
 	}
+	errs = append(errs, fmt.Errorf(
+		"%q contains an invalid value %q. Valid values are `%s`", key, value, strings.Join(widgetLegendSizes, "`, `")))
 	return
 }
+
+//
+// Formula and Function query helpers
+//
+// The generated client does not expose the `formulas`/`queries` fields of
+// widget requests yet, so widgets using the new query editor are serialized
+// through raw JSON, see injectDashboardFormulas.
+//
+
+// formulaRequestResponseFormats maps widget definition schema keys whose
+// requests support formula and function queries to the response_format the API
+// expects for that widget type.
+var formulaRequestResponseFormats = map[string]string{
+	"query_value_definition": "scalar",
+	"query_table_definition": "scalar",
+}
+
+func getFormulaSchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Nested block describing a formula applied to the queries of the request. Multiple `formula` blocks are allowed with the structure below.",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"formula_expression": {
+					Description: "String expression built from queries, formulas and functions, eg. `query1 + query2 / 100`.",
+					Type:        schema.TypeString,
+					Required:    true,
+				},
+				"alias": {
+					Description: "An expression alias.",
+					Type:        schema.TypeString,
+					Optional:    true,
+				},
+				"cell_display_mode": {
+					Description:  "How the cells of this formula are rendered in a query table widget. One of `number`, `bar`, `trend`; `trend` renders a sparkline column of the values over the timeframe.",
+					Type:         schema.TypeString,
+					Optional:     true,
+					ValidateFunc: validation.StringInSlice([]string{"number", "bar", "trend"}, false),
+				},
+				"style": {
+					Description: "Styling options for the lines plotted from this formula.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"palette": {
+								Description: "The color palette used to display the formula, e.g. `classic` or `warm`. A semantic palette such as `green` pins the formula to that color family.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+							"palette_index": {
+								Description: "Index of the color to pick in the palette, starting at 0.",
+								Type:        schema.TypeInt,
+								Optional:    true,
+								Default:     -1,
+							},
+						},
+					},
+				},
+				"limit": {
+					Description: "The options for limiting results returned.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"count": {
+								Description: "The number of results to return.",
+								Type:        schema.TypeInt,
+								Optional:    true,
+							},
+							"order": {
+								Description:  "The direction of the sort, either 'asc' or 'desc'.",
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "desc",
+								ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func getFormulaQuerySchema() *schema.Schema {
+	return &schema.Schema{
+		Description: "Nested block describing a query used by a formula. Multiple `query` blocks are allowed with the structure below (exactly one of `metric_query`, `event_query`, `process_query` or `apm_dependency_stats_query` is required within each block).",
+		Type:        schema.TypeList,
+		Optional:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"metric_query": {
+					Description: "A timeseries formula and functions metrics query.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"data_source": {
+								Description: "The data source for metrics queries.",
+								Type:        schema.TypeString,
+								Optional:    true,
+								Default:     "metrics",
+							},
+							"query": {
+								Description: "The metrics query definition.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"name": {
+								Description: "The name of the query for use in formulas.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"aggregator": {
+								Description:  "The aggregation methods available for metrics queries.",
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"avg", "min", "max", "sum", "last", "area", "l2norm", "percentile"}, false),
+							},
+						},
+					},
+				},
+				"event_query": {
+					Description: "A timeseries formula and functions events query.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"data_source": {
+								Description:  "The data source for event platform-based queries.",
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{"logs", "spans", "network", "rum", "security_signals", "profiles", "audit", "events"}, false),
+							},
+							"name": {
+								Description: "The name of the query for use in formulas.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"indexes": {
+								Description: "An array of index names to query in the stream.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"search": {
+								Description: "The search options.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"query": {
+											Description: "The events search string.",
+											Type:        schema.TypeString,
+											Required:    true,
+										},
+									},
+								},
+							},
+							"compute": {
+								Description: "The compute options.",
+								Type:        schema.TypeList,
+								Required:    true,
+								MaxItems:    1,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"aggregation": {
+											Description: "The aggregation methods for event platform queries.",
+											Type:        schema.TypeString,
+											Required:    true,
+										},
+										"interval": {
+											Description: "A time interval in milliseconds.",
+											Type:        schema.TypeInt,
+											Optional:    true,
+										},
+										"metric": {
+											Description: "The measurable attribute to compute.",
+											Type:        schema.TypeString,
+											Optional:    true,
+										},
+									},
+								},
+							},
+							"group_by": {
+								Description: "Group by options.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"facet": {
+											Description: "The event facet.",
+											Type:        schema.TypeString,
+											Required:    true,
+										},
+										"limit": {
+											Description: "The number of groups to return.",
+											Type:        schema.TypeInt,
+											Optional:    true,
+										},
+										"sort": {
+											Description: "The options for sorting group by results.",
+											Type:        schema.TypeList,
+											Optional:    true,
+											MaxItems:    1,
+											Elem: &schema.Resource{
+												Schema: map[string]*schema.Schema{
+													"aggregation": {
+														Description: "The aggregation methods for the event platform queries.",
+														Type:        schema.TypeString,
+														Required:    true,
+													},
+													"metric": {
+														Description: "The metric used for sorting group by results.",
+														Type:        schema.TypeString,
+														Optional:    true,
+													},
+													"order": {
+														Description:  "Direction of sort, either 'asc' or 'desc'.",
+														Type:         schema.TypeString,
+														Optional:     true,
+														ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				"process_query": {
+					Description: "The process query using formulas and functions.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"data_source": {
+								Description:  "The data source for process queries, either 'process' or 'container'.",
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{"process", "container"}, false),
+							},
+							"metric": {
+								Description: "The process metric name.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"name": {
+								Description: "The name of the query for use in formulas.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"text_filter": {
+								Description: "The text to use as a filter.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+							"tag_filters": {
+								Description: "An array of tags to filter by.",
+								Type:        schema.TypeList,
+								Optional:    true,
+								Elem:        &schema.Schema{Type: schema.TypeString},
+							},
+							"limit": {
+								Description: "The number of hits to return.",
+								Type:        schema.TypeInt,
+								Optional:    true,
+							},
+							"sort": {
+								Description:  "The direction of the sort, either 'asc' or 'desc'.",
+								Type:         schema.TypeString,
+								Optional:     true,
+								Default:      "desc",
+								ValidateFunc: validation.StringInSlice([]string{"asc", "desc"}, false),
+							},
+							"is_normalized_cpu": {
+								Description: "Whether to normalize the CPU percentages.",
+								Type:        schema.TypeBool,
+								Optional:    true,
+							},
+							"aggregator": {
+								Description:  "The aggregation methods available for process queries.",
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"avg", "min", "max", "sum", "last"}, false),
+							},
+						},
+					},
+				},
+				"apm_dependency_stats_query": {
+					Description: "The APM Dependency Stats query using formulas and functions.",
+					Type:        schema.TypeList,
+					Optional:    true,
+					MaxItems:    1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"data_source": {
+								Description:  "The data source for APM Dependency Stats queries.",
+								Type:         schema.TypeString,
+								Required:     true,
+								ValidateFunc: validation.StringInSlice([]string{"apm_dependency_stats"}, false),
+							},
+							"name": {
+								Description: "The name of the query for use in formulas.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"env": {
+								Description: "The APM environment.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"service": {
+								Description: "The APM service.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"operation_name": {
+								Description: "The name of the operation.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"resource_name": {
+								Description: "The APM resource.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"stat": {
+								Description: "The APM statistic to fetch.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+							"primary_tag_name": {
+								Description: "The name of the second primary tag used within APM. `primary_tag_value` must also be specified.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+							"primary_tag_value": {
+								Description: "The value of the second primary tag by which to filter APM data.",
+								Type:        schema.TypeString,
+								Optional:    true,
+							},
+							"is_upstream": {
+								Description: "Determines whether stats for upstream or downstream dependencies should be queried.",
+								Type:        schema.TypeBool,
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildDatadogFormulas(terraformFormulas []interface{}) []map[string]interface{} {
+	datadogFormulas := make([]map[string]interface{}, 0, len(terraformFormulas))
+	for _, f := range terraformFormulas {
+		terraformFormula, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		datadogFormula := map[string]interface{}{
+			"formula": terraformFormula["formula_expression"],
+		}
+		if v, ok := terraformFormula["alias"].(string); ok && len(v) != 0 {
+			datadogFormula["alias"] = v
+		}
+		if v, ok := terraformFormula["cell_display_mode"].(string); ok && len(v) != 0 {
+			datadogFormula["cell_display_mode"] = v
+		}
+		if v, ok := terraformFormula["style"].([]interface{}); ok && len(v) != 0 {
+			terraformStyle := v[0].(map[string]interface{})
+			datadogStyle := map[string]interface{}{}
+			if palette, ok := terraformStyle["palette"].(string); ok && len(palette) != 0 {
+				datadogStyle["palette"] = palette
+			}
+			// -1 is the schema default standing in for "not set", as index 0
+			// picks the first color of the palette.
+			if index, ok := terraformStyle["palette_index"].(int); ok && index != -1 {
+				datadogStyle["palette_index"] = index
+			}
+			datadogFormula["style"] = datadogStyle
+		}
+		if v, ok := terraformFormula["limit"].([]interface{}); ok && len(v) != 0 {
+			terraformLimit := v[0].(map[string]interface{})
+			datadogLimit := map[string]interface{}{}
+			if count, ok := terraformLimit["count"].(int); ok && count != 0 {
+				datadogLimit["count"] = count
+			}
+			if order, ok := terraformLimit["order"].(string); ok && len(order) != 0 {
+				datadogLimit["order"] = order
+			}
+			datadogFormula["limit"] = datadogLimit
+		}
+		datadogFormulas = append(datadogFormulas, datadogFormula)
+	}
+	return datadogFormulas
+}
+
+func buildDatadogFormulaQueries(terraformQueries []interface{}) []map[string]interface{} {
+	datadogQueries := make([]map[string]interface{}, 0, len(terraformQueries))
+	for _, q := range terraformQueries {
+		terraformQuery, ok := q.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if v, ok := terraformQuery["metric_query"].([]interface{}); ok && len(v) != 0 {
+			datadogQueries = append(datadogQueries, buildDatadogMetricQuery(v[0].(map[string]interface{})))
+		} else if v, ok := terraformQuery["event_query"].([]interface{}); ok && len(v) != 0 {
+			datadogQueries = append(datadogQueries, buildDatadogFormulaEventQuery(v[0].(map[string]interface{})))
+		} else if v, ok := terraformQuery["process_query"].([]interface{}); ok && len(v) != 0 {
+			datadogQueries = append(datadogQueries, buildDatadogFormulaProcessQuery(v[0].(map[string]interface{})))
+		} else if v, ok := terraformQuery["apm_dependency_stats_query"].([]interface{}); ok && len(v) != 0 {
+			datadogQueries = append(datadogQueries, buildDatadogApmDependencyStatsQuery(v[0].(map[string]interface{})))
+		}
+	}
+	return datadogQueries
+}
+
+func buildDatadogMetricQuery(terraformQuery map[string]interface{}) map[string]interface{} {
+	datadogQuery := map[string]interface{}{
+		"data_source": terraformQuery["data_source"],
+		"query":       terraformQuery["query"],
+		"name":        terraformQuery["name"],
+	}
+	if v, ok := terraformQuery["aggregator"].(string); ok && len(v) != 0 {
+		datadogQuery["aggregator"] = v
+	}
+	return datadogQuery
+}
+
+func buildDatadogFormulaEventQuery(terraformQuery map[string]interface{}) map[string]interface{} {
+	datadogQuery := map[string]interface{}{
+		"data_source": terraformQuery["data_source"],
+		"name":        terraformQuery["name"],
+	}
+	if v, ok := terraformQuery["indexes"].([]interface{}); ok && len(v) != 0 {
+		datadogQuery["indexes"] = v
+	}
+	if v, ok := terraformQuery["search"].([]interface{}); ok && len(v) != 0 {
+		terraformSearch := v[0].(map[string]interface{})
+		datadogQuery["search"] = map[string]interface{}{"query": terraformSearch["query"]}
+	}
+	if v, ok := terraformQuery["compute"].([]interface{}); ok && len(v) != 0 {
+		terraformCompute := v[0].(map[string]interface{})
+		datadogCompute := map[string]interface{}{"aggregation": terraformCompute["aggregation"]}
+		if interval, ok := terraformCompute["interval"].(int); ok && interval != 0 {
+			datadogCompute["interval"] = interval
+		}
+		if metric, ok := terraformCompute["metric"].(string); ok && len(metric) != 0 {
+			datadogCompute["metric"] = metric
+		}
+		datadogQuery["compute"] = datadogCompute
+	}
+	if v, ok := terraformQuery["group_by"].([]interface{}); ok && len(v) != 0 {
+		datadogGroupBys := make([]map[string]interface{}, 0, len(v))
+		for _, g := range v {
+			terraformGroupBy := g.(map[string]interface{})
+			datadogGroupBy := map[string]interface{}{"facet": terraformGroupBy["facet"]}
+			if limit, ok := terraformGroupBy["limit"].(int); ok && limit != 0 {
+				datadogGroupBy["limit"] = limit
+			}
+			if sorts, ok := terraformGroupBy["sort"].([]interface{}); ok && len(sorts) != 0 {
+				terraformSort := sorts[0].(map[string]interface{})
+				datadogSort := map[string]interface{}{"aggregation": terraformSort["aggregation"]}
+				if metric, ok := terraformSort["metric"].(string); ok && len(metric) != 0 {
+					datadogSort["metric"] = metric
+				}
+				if order, ok := terraformSort["order"].(string); ok && len(order) != 0 {
+					datadogSort["order"] = order
+				}
+				datadogGroupBy["sort"] = datadogSort
+			}
+			datadogGroupBys = append(datadogGroupBys, datadogGroupBy)
+		}
+		datadogQuery["group_by"] = datadogGroupBys
+	}
+	return datadogQuery
+}
+
+func buildDatadogFormulaProcessQuery(terraformQuery map[string]interface{}) map[string]interface{} {
+	datadogQuery := map[string]interface{}{
+		"data_source": terraformQuery["data_source"],
+		"metric":      terraformQuery["metric"],
+		"name":        terraformQuery["name"],
+	}
+	if v, ok := terraformQuery["text_filter"].(string); ok && len(v) != 0 {
+		datadogQuery["text_filter"] = v
+	}
+	if v, ok := terraformQuery["tag_filters"].([]interface{}); ok && len(v) != 0 {
+		datadogQuery["tag_filters"] = v
+	}
+	if v, ok := terraformQuery["limit"].(int); ok && v != 0 {
+		datadogQuery["limit"] = v
+	}
+	if v, ok := terraformQuery["sort"].(string); ok && len(v) != 0 {
+		datadogQuery["sort"] = v
+	}
+	if v, ok := terraformQuery["is_normalized_cpu"].(bool); ok && v {
+		datadogQuery["is_normalized_cpu"] = v
+	}
+	if v, ok := terraformQuery["aggregator"].(string); ok && len(v) != 0 {
+		datadogQuery["aggregator"] = v
+	}
+	return datadogQuery
+}
+
+func buildDatadogApmDependencyStatsQuery(terraformQuery map[string]interface{}) map[string]interface{} {
+	datadogQuery := map[string]interface{}{
+		"data_source":    terraformQuery["data_source"],
+		"name":           terraformQuery["name"],
+		"env":            terraformQuery["env"],
+		"service":        terraformQuery["service"],
+		"operation_name": terraformQuery["operation_name"],
+		"resource_name":  terraformQuery["resource_name"],
+		"stat":           terraformQuery["stat"],
+	}
+	if v, ok := terraformQuery["primary_tag_name"].(string); ok && len(v) != 0 {
+		datadogQuery["primary_tag_name"] = v
+	}
+	if v, ok := terraformQuery["primary_tag_value"].(string); ok && len(v) != 0 {
+		datadogQuery["primary_tag_value"] = v
+	}
+	if v, ok := terraformQuery["is_upstream"].(bool); ok && v {
+		datadogQuery["is_upstream"] = v
+	}
+	return datadogQuery
+}
+
+func buildTerraformFormulas(datadogFormulas []interface{}) []map[string]interface{} {
+	terraformFormulas := make([]map[string]interface{}, 0, len(datadogFormulas))
+	for _, f := range datadogFormulas {
+		datadogFormula, ok := f.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		terraformFormula := map[string]interface{}{
+			"formula_expression": datadogFormula["formula"],
+		}
+		if v, ok := datadogFormula["alias"]; ok {
+			terraformFormula["alias"] = v
+		}
+		if v, ok := datadogFormula["cell_display_mode"]; ok {
+			terraformFormula["cell_display_mode"] = v
+		}
+		if v, ok := datadogFormula["style"].(map[string]interface{}); ok {
+			terraformStyle := map[string]interface{}{}
+			if palette, ok := v["palette"]; ok {
+				terraformStyle["palette"] = palette
+			}
+			if index, ok := v["palette_index"]; ok {
+				terraformStyle["palette_index"] = index
+			}
+			terraformFormula["style"] = []map[string]interface{}{terraformStyle}
+		}
+		if v, ok := datadogFormula["limit"].(map[string]interface{}); ok {
+			terraformLimit := map[string]interface{}{}
+			if count, ok := v["count"]; ok {
+				terraformLimit["count"] = count
+			}
+			if order, ok := v["order"]; ok {
+				terraformLimit["order"] = order
+			}
+			terraformFormula["limit"] = []map[string]interface{}{terraformLimit}
+		}
+		terraformFormulas = append(terraformFormulas, terraformFormula)
+	}
+	return terraformFormulas
+}
+
+func buildTerraformFormulaQueries(datadogQueries []interface{}) []map[string]interface{} {
+	terraformQueries := make([]map[string]interface{}, 0, len(datadogQueries))
+	for _, q := range datadogQueries {
+		datadogQuery, ok := q.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		terraformQuery := map[string]interface{}{}
+		switch datadogQuery["data_source"] {
+		case "metrics", "cloud_cost":
+			terraformQuery["metric_query"] = []map[string]interface{}{buildTerraformMetricQuery(datadogQuery)}
+		case "process", "container":
+			terraformQuery["process_query"] = []map[string]interface{}{buildTerraformFormulaProcessQuery(datadogQuery)}
+		case "apm_dependency_stats":
+			terraformQuery["apm_dependency_stats_query"] = []map[string]interface{}{buildTerraformApmDependencyStatsQuery(datadogQuery)}
+		default:
+			terraformQuery["event_query"] = []map[string]interface{}{buildTerraformFormulaEventQuery(datadogQuery)}
+		}
+		terraformQueries = append(terraformQueries, terraformQuery)
+	}
+	return terraformQueries
+}
+
+func buildTerraformMetricQuery(datadogQuery map[string]interface{}) map[string]interface{} {
+	terraformQuery := map[string]interface{}{
+		"data_source": datadogQuery["data_source"],
+		"query":       datadogQuery["query"],
+		"name":        datadogQuery["name"],
+	}
+	if v, ok := datadogQuery["aggregator"]; ok {
+		terraformQuery["aggregator"] = v
+	}
+	return terraformQuery
+}
+
+func buildTerraformFormulaEventQuery(datadogQuery map[string]interface{}) map[string]interface{} {
+	terraformQuery := map[string]interface{}{
+		"data_source": datadogQuery["data_source"],
+		"name":        datadogQuery["name"],
+	}
+	if v, ok := datadogQuery["indexes"]; ok {
+		terraformQuery["indexes"] = v
+	}
+	if v, ok := datadogQuery["search"].(map[string]interface{}); ok {
+		terraformQuery["search"] = []map[string]interface{}{{"query": v["query"]}}
+	}
+	if v, ok := datadogQuery["compute"].(map[string]interface{}); ok {
+		terraformCompute := map[string]interface{}{"aggregation": v["aggregation"]}
+		if interval, ok := v["interval"]; ok {
+			terraformCompute["interval"] = interval
+		}
+		if metric, ok := v["metric"]; ok {
+			terraformCompute["metric"] = metric
+		}
+		terraformQuery["compute"] = []map[string]interface{}{terraformCompute}
+	}
+	if v, ok := datadogQuery["group_by"].([]interface{}); ok {
+		terraformGroupBys := make([]map[string]interface{}, 0, len(v))
+		for _, g := range v {
+			datadogGroupBy := g.(map[string]interface{})
+			terraformGroupBy := map[string]interface{}{"facet": datadogGroupBy["facet"]}
+			if limit, ok := datadogGroupBy["limit"]; ok {
+				terraformGroupBy["limit"] = limit
+			}
+			if sort, ok := datadogGroupBy["sort"].(map[string]interface{}); ok {
+				terraformSort := map[string]interface{}{"aggregation": sort["aggregation"]}
+				if metric, ok := sort["metric"]; ok {
+					terraformSort["metric"] = metric
+				}
+				if order, ok := sort["order"]; ok {
+					terraformSort["order"] = order
+				}
+				terraformGroupBy["sort"] = []map[string]interface{}{terraformSort}
+			}
+			terraformGroupBys = append(terraformGroupBys, terraformGroupBy)
+		}
+		terraformQuery["group_by"] = terraformGroupBys
+	}
+	return terraformQuery
+}
+
+func buildTerraformFormulaProcessQuery(datadogQuery map[string]interface{}) map[string]interface{} {
+	terraformQuery := map[string]interface{}{
+		"data_source": datadogQuery["data_source"],
+		"metric":      datadogQuery["metric"],
+		"name":        datadogQuery["name"],
+	}
+	for _, key := range []string{"text_filter", "tag_filters", "limit", "sort", "is_normalized_cpu", "aggregator"} {
+		if v, ok := datadogQuery[key]; ok {
+			terraformQuery[key] = v
+		}
+	}
+	return terraformQuery
+}
+
+func buildTerraformApmDependencyStatsQuery(datadogQuery map[string]interface{}) map[string]interface{} {
+	terraformQuery := map[string]interface{}{
+		"data_source":    datadogQuery["data_source"],
+		"name":           datadogQuery["name"],
+		"env":            datadogQuery["env"],
+		"service":        datadogQuery["service"],
+		"operation_name": datadogQuery["operation_name"],
+		"resource_name":  datadogQuery["resource_name"],
+		"stat":           datadogQuery["stat"],
+	}
+	for _, key := range []string{"primary_tag_name", "primary_tag_value", "is_upstream"} {
+		if v, ok := datadogQuery[key]; ok {
+			terraformQuery[key] = v
+		}
+	}
+	return terraformQuery
+}
+
+// widgetDefinitionList returns the single-item definition list stored under
+// the given schema key, handling both the config ([]interface{}) and state
+// ([]map[string]interface{}) representations.
+func widgetDefinitionList(widget map[string]interface{}, key string) []map[string]interface{} {
+	switch v := widget[key].(type) {
+	case []interface{}:
+		defs := make([]map[string]interface{}, 0, len(v))
+		for _, def := range v {
+			if m, ok := def.(map[string]interface{}); ok {
+				defs = append(defs, m)
+			}
+		}
+		return defs
+	case []map[string]interface{}:
+		return v
+	}
+	return nil
+}
+
+// dashboardUsesFormulas returns true when at least one widget request of the
+// dashboard uses formula and function queries.
+func dashboardUsesFormulas(terraformWidgets []interface{}) bool {
+	for _, w := range terraformWidgets {
+		widget, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, group := range widgetDefinitionList(widget, "group_definition") {
+			if subWidgets, ok := group["widget"].([]interface{}); ok && dashboardUsesFormulas(subWidgets) {
+				return true
+			}
+		}
+		for definitionKey := range formulaRequestResponseFormats {
+			for _, definition := range widgetDefinitionList(widget, definitionKey) {
+				requests, ok := definition["request"].([]interface{})
+				if !ok {
+					continue
+				}
+				for _, r := range requests {
+					request, ok := r.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if queries, ok := request["query"].([]interface{}); ok && len(queries) != 0 {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// injectDashboardFormulas copies the formula and function queries of the
+// Terraform widgets into the matching requests of the JSON-serialized
+// dashboard, which is walked in parallel.
+func injectDashboardFormulas(terraformWidgets []interface{}, jsonWidgets []interface{}) {
+	for i, w := range terraformWidgets {
+		if i >= len(jsonWidgets) {
+			return
+		}
+		widget, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		jsonWidget, ok := jsonWidgets[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		jsonDefinition, ok := jsonWidget["definition"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, group := range widgetDefinitionList(widget, "group_definition") {
+			subWidgets, ok := group["widget"].([]interface{})
+			jsonSubWidgets, jsonOk := jsonDefinition["widgets"].([]interface{})
+			if ok && jsonOk {
+				injectDashboardFormulas(subWidgets, jsonSubWidgets)
+			}
+		}
+		for definitionKey, responseFormat := range formulaRequestResponseFormats {
+			for _, definition := range widgetDefinitionList(widget, definitionKey) {
+				requests, ok := definition["request"].([]interface{})
+				jsonRequests, jsonOk := jsonDefinition["requests"].([]interface{})
+				if !ok || !jsonOk {
+					continue
+				}
+				for j, r := range requests {
+					if j >= len(jsonRequests) {
+						break
+					}
+					request, ok := r.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					queries, ok := request["query"].([]interface{})
+					if !ok || len(queries) == 0 {
+						continue
+					}
+					jsonRequest, ok := jsonRequests[j].(map[string]interface{})
+					if !ok {
+						continue
+					}
+					jsonRequest["queries"] = buildDatadogFormulaQueries(queries)
+					if formulas, ok := request["formula"].([]interface{}); ok && len(formulas) != 0 {
+						jsonRequest["formulas"] = buildDatadogFormulas(formulas)
+					}
+					jsonRequest["response_format"] = responseFormat
+				}
+			}
+		}
+	}
+}
+
+// extractDashboardFormulas walks the raw JSON widgets of a dashboard in
+// parallel with the Terraform widgets built from the typed response, and loads
+// the formula and function queries the generated client dropped.
+func extractDashboardFormulas(terraformWidgets []map[string]interface{}, jsonWidgets []interface{}) {
+	for i, widget := range terraformWidgets {
+		if i >= len(jsonWidgets) {
+			return
+		}
+		jsonWidget, ok := jsonWidgets[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		jsonDefinition, ok := jsonWidget["definition"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, group := range widgetDefinitionList(widget, "group_definition") {
+			subWidgets, ok := group["widget"].([]map[string]interface{})
+			jsonSubWidgets, jsonOk := jsonDefinition["widgets"].([]interface{})
+			if ok && jsonOk {
+				extractDashboardFormulas(subWidgets, jsonSubWidgets)
+			}
+		}
+		for definitionKey := range formulaRequestResponseFormats {
+			for _, definition := range widgetDefinitionList(widget, definitionKey) {
+				requests, ok := definition["request"].(*[]map[string]interface{})
+				jsonRequests, jsonOk := jsonDefinition["requests"].([]interface{})
+				if !ok || !jsonOk {
+					continue
+				}
+				for j := range *requests {
+					if j >= len(jsonRequests) {
+						break
+					}
+					jsonRequest, ok := jsonRequests[j].(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if queries, ok := jsonRequest["queries"].([]interface{}); ok && len(queries) != 0 {
+						(*requests)[j]["query"] = buildTerraformFormulaQueries(queries)
+					}
+					if formulas, ok := jsonRequest["formulas"].([]interface{}); ok && len(formulas) != 0 {
+						(*requests)[j]["formula"] = buildTerraformFormulas(formulas)
+					}
+				}
+			}
+		}
+	}
+}
+
+// eventOverlayDefinitionKeys lists the widget definition schema keys whose
+// definitions support event overlays.
+var eventOverlayDefinitionKeys = []string{"heatmap_definition", "timeseries_definition"}
+
+// dashboardNeedsRawApi returns true when the dashboard has to go through the
+// raw JSON API because the generated client cannot carry parts of it: formula
+// and function queries, or extended event overlay options.
+func dashboardNeedsRawApi(terraformWidgets []interface{}) bool {
+	return dashboardUsesFormulas(terraformWidgets) || dashboardUsesExtendedEvents(terraformWidgets)
+}
+
+// dashboardUsesExtendedEvents returns true when at least one event overlay of
+// the dashboard uses options the generated client cannot carry.
+func dashboardUsesExtendedEvents(terraformWidgets []interface{}) bool {
+	for _, w := range terraformWidgets {
+		widget, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, group := range widgetDefinitionList(widget, "group_definition") {
+			if subWidgets, ok := group["widget"].([]interface{}); ok && dashboardUsesExtendedEvents(subWidgets) {
+				return true
+			}
+		}
+		for _, definitionKey := range eventOverlayDefinitionKeys {
+			for _, definition := range widgetDefinitionList(widget, definitionKey) {
+				events, ok := definition["event"].([]interface{})
+				if !ok {
+					continue
+				}
+				for _, e := range events {
+					event, ok := e.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if excludedTags, ok := event["excluded_tags"].([]interface{}); ok && len(excludedTags) != 0 {
+						return true
+					}
+					if style, ok := event["style"].([]interface{}); ok && len(style) != 0 {
+						return true
+					}
+				}
+			}
+		}
+	}
+	return false
+}
+
+// injectDashboardEventOverlays copies the extended event overlay options of
+// the Terraform widgets into the matching events of the JSON-serialized
+// dashboard, which is walked in parallel.
+func injectDashboardEventOverlays(terraformWidgets []interface{}, jsonWidgets []interface{}) {
+	for i, w := range terraformWidgets {
+		if i >= len(jsonWidgets) {
+			return
+		}
+		widget, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		jsonWidget, ok := jsonWidgets[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		jsonDefinition, ok := jsonWidget["definition"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, group := range widgetDefinitionList(widget, "group_definition") {
+			subWidgets, ok := group["widget"].([]interface{})
+			jsonSubWidgets, jsonOk := jsonDefinition["widgets"].([]interface{})
+			if ok && jsonOk {
+				injectDashboardEventOverlays(subWidgets, jsonSubWidgets)
+			}
+		}
+		for _, definitionKey := range eventOverlayDefinitionKeys {
+			for _, definition := range widgetDefinitionList(widget, definitionKey) {
+				events, ok := definition["event"].([]interface{})
+				jsonEvents, jsonOk := jsonDefinition["events"].([]interface{})
+				if !ok || !jsonOk {
+					continue
+				}
+				for j, e := range events {
+					if j >= len(jsonEvents) {
+						break
+					}
+					event, ok := e.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					jsonEvent, ok := jsonEvents[j].(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if excludedTags, ok := event["excluded_tags"].([]interface{}); ok && len(excludedTags) != 0 {
+						jsonEvent["excluded_tags"] = excludedTags
+					}
+					if style, ok := event["style"].([]interface{}); ok && len(style) != 0 {
+						terraformStyle := style[0].(map[string]interface{})
+						jsonStyle := map[string]interface{}{}
+						if palette, ok := terraformStyle["palette"].(string); ok && len(palette) != 0 {
+							jsonStyle["palette"] = palette
+						}
+						jsonEvent["style"] = jsonStyle
+					}
+				}
+			}
+		}
+	}
+}
+
+// extractDashboardEventOverlays walks the raw JSON widgets of a dashboard in
+// parallel with the Terraform widgets built from the typed response, and loads
+// the extended event overlay options the generated client dropped.
+func extractDashboardEventOverlays(terraformWidgets []map[string]interface{}, jsonWidgets []interface{}) {
+	for i, widget := range terraformWidgets {
+		if i >= len(jsonWidgets) {
+			return
+		}
+		jsonWidget, ok := jsonWidgets[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		jsonDefinition, ok := jsonWidget["definition"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, group := range widgetDefinitionList(widget, "group_definition") {
+			subWidgets, ok := group["widget"].([]map[string]interface{})
+			jsonSubWidgets, jsonOk := jsonDefinition["widgets"].([]interface{})
+			if ok && jsonOk {
+				extractDashboardEventOverlays(subWidgets, jsonSubWidgets)
+			}
+		}
+		for _, definitionKey := range eventOverlayDefinitionKeys {
+			for _, definition := range widgetDefinitionList(widget, definitionKey) {
+				events, ok := definition["event"].(*[]map[string]interface{})
+				jsonEvents, jsonOk := jsonDefinition["events"].([]interface{})
+				if !ok || !jsonOk {
+					continue
+				}
+				for j := range *events {
+					if j >= len(jsonEvents) {
+						break
+					}
+					jsonEvent, ok := jsonEvents[j].(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if excludedTags, ok := jsonEvent["excluded_tags"].([]interface{}); ok && len(excludedTags) != 0 {
+						(*events)[j]["excluded_tags"] = excludedTags
+					}
+					if style, ok := jsonEvent["style"].(map[string]interface{}); ok {
+						terraformStyle := map[string]interface{}{}
+						if palette, ok := style["palette"]; ok {
+							terraformStyle["palette"] = palette
+						}
+						(*events)[j]["style"] = []map[string]interface{}{terraformStyle}
+					}
+				}
+			}
+		}
+	}
+}
+
+// buildUnmodeledWidgetFields compares the raw JSON definition of each widget
+// with the definition the typed client retained, and returns the fields the
+// provider does not model yet as one JSON object per widget. The raw widgets
+// are walked in parallel with the typed ones.
+func buildUnmodeledWidgetFields(typedWidgets []datadogV1.Widget, rawWidgets []interface{}) []string {
+	unmodeled := make([]string, len(typedWidgets))
+	for i := range typedWidgets {
+		unmodeled[i] = "{}"
+		marshaled, err := json.Marshal(typedWidgets[i])
+		if err != nil {
+			continue
+		}
+		var typedWidget map[string]interface{}
+		if err := json.Unmarshal(marshaled, &typedWidget); err != nil {
+			continue
+		}
+		typedDefinition, ok := typedWidget["definition"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rawWidget, ok := rawWidgets[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		rawDefinition, ok := rawWidget["definition"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		extra := map[string]interface{}{}
+		for key, value := range rawDefinition {
+			if _, modeled := typedDefinition[key]; !modeled {
+				extra[key] = value
+			}
+		}
+		if len(extra) == 0 {
+			continue
+		}
+		if encoded, err := json.Marshal(extra); err == nil {
+			unmodeled[i] = string(encoded)
+		}
+	}
+	return unmodeled
+}
+
+// mergeUnmodeledWidgetFields merges the recorded unmodeled widget fields back
+// into the definitions of the raw update payload. Fields set by the
+// configuration win over the recorded ones.
+func mergeUnmodeledWidgetFields(rawPayload map[string]interface{}, unmodeledWidgets []interface{}) {
+	widgets, ok := rawPayload["widgets"].([]interface{})
+	if !ok {
+		return
+	}
+	for i, u := range unmodeledWidgets {
+		if i >= len(widgets) {
+			return
+		}
+		encoded, ok := u.(string)
+		if !ok {
+			continue
+		}
+		var extra map[string]interface{}
+		if err := json.Unmarshal([]byte(encoded), &extra); err != nil || len(extra) == 0 {
+			continue
+		}
+		widget, ok := widgets[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		definition, ok := widget["definition"].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for key, value := range extra {
+			if _, exists := definition[key]; !exists {
+				definition[key] = value
+			}
+		}
+	}
+}
+
+// dashboardWithFormulas serializes the typed dashboard payload and injects the
+// formula and function queries and extended event overlay options the
+// generated client cannot carry.
+func dashboardWithFormulas(d *schema.ResourceData, dashboard *datadogV1.Dashboard) (map[string]interface{}, error) {
+	marshaled, err := json.Marshal(dashboard)
+	if err != nil {
+		return nil, err
+	}
+	var rawDashboard map[string]interface{}
+	if err := json.Unmarshal(marshaled, &rawDashboard); err != nil {
+		return nil, err
+	}
+	terraformWidgets := d.Get("widget").([]interface{})
+	if jsonWidgets, ok := rawDashboard["widgets"].([]interface{}); ok {
+		injectDashboardFormulas(terraformWidgets, jsonWidgets)
+		injectDashboardEventOverlays(terraformWidgets, jsonWidgets)
+	}
+	if v, ok := d.GetOk("widget_query_overrides"); ok {
+		if overrides := v.(map[string]interface{}); len(overrides) > 0 {
+			encoded, err := json.Marshal(rawDashboard["widgets"])
+			if err != nil {
+				return nil, err
+			}
+			substituted, err := substituteWidgetQueryOverrides(encoded, overrides)
+			if err != nil {
+				return nil, err
+			}
+			var widgets interface{}
+			if err := json.Unmarshal(substituted, &widgets); err != nil {
+				return nil, err
+			}
+			rawDashboard["widgets"] = widgets
+		}
+	}
+	return rawDashboard, nil
+}
+
+//
+// Widget query override helpers
+//
+
+// substituteWidgetQueryOverrides replaces `{{name}}` placeholders in the
+// JSON-encoded widgets with the configured override values. The widgets are
+// walked structurally and only string values are rewritten, so a placeholder
+// never leaks into keys or non-string fields.
+func substituteWidgetQueryOverrides(encoded []byte, overrides map[string]interface{}) ([]byte, error) {
+	var widgets interface{}
+	if err := json.Unmarshal(encoded, &widgets); err != nil {
+		return nil, err
+	}
+	return json.Marshal(substituteOverridesInWidgetValues(widgets, overrides))
+}
+
+// substituteOverridesInWidgetValues walks the decoded widgets and substitutes
+// the placeholders in every string value.
+func substituteOverridesInWidgetValues(value interface{}, overrides map[string]interface{}) interface{} {
+	switch value := value.(type) {
+	case string:
+		return substituteOverridePlaceholders(value, overrides)
+	case map[string]interface{}:
+		for key, entry := range value {
+			value[key] = substituteOverridesInWidgetValues(entry, overrides)
+		}
+		return value
+	case []interface{}:
+		for i, entry := range value {
+			value[i] = substituteOverridesInWidgetValues(entry, overrides)
+		}
+		return value
+	default:
+		return value
+	}
+}
+
+// substituteOverridePlaceholders replaces the `{{name}}` placeholders in a
+// single string value. Keys are applied in sorted order so the result is
+// deterministic.
+func substituteOverridePlaceholders(value string, overrides map[string]interface{}) string {
+	keys := make([]string, 0, len(overrides))
+	for key := range overrides {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value = strings.ReplaceAll(value, "{{"+key+"}}", overrides[key].(string))
+	}
+	return value
+}
+
+// revertWidgetQueryOverrides is the inverse of substituteWidgetQueryOverrides:
+// it turns override values in the freshly read widgets back into their
+// `{{name}}` placeholders. The widgets from the prior state serve as the
+// template recording where placeholders were substituted, so an override
+// value that also shows up in unrelated content (e.g. a widget title) is
+// left alone.
+func revertWidgetQueryOverrides(terraformWidgets *[]map[string]interface{}, templateWidgets []interface{}, overrides map[string]interface{}) (*[]map[string]interface{}, error) {
+	encoded, err := json.Marshal(terraformWidgets)
+	if err != nil {
+		return nil, err
+	}
+	var fresh interface{}
+	if err := json.Unmarshal(encoded, &fresh); err != nil {
+		return nil, err
+	}
+	encodedTemplate, err := json.Marshal(templateWidgets)
+	if err != nil {
+		return nil, err
+	}
+	var template interface{}
+	if err := json.Unmarshal(encodedTemplate, &template); err != nil {
+		return nil, err
+	}
+	merged, err := json.Marshal(revertOverriddenWidgetValues(fresh, template, overrides))
+	if err != nil {
+		return nil, err
+	}
+	var reverted []map[string]interface{}
+	if err := json.Unmarshal(merged, &reverted); err != nil {
+		return nil, err
+	}
+	return &reverted, nil
+}
+
+// revertOverriddenWidgetValues walks the freshly read widgets in parallel
+// with the template. Only string values whose template contains a placeholder
+// and whose read-back value matches the substituted template are reverted; on
+// any mismatch the read-back value wins, so out-of-band edits still surface
+// as drift.
+func revertOverriddenWidgetValues(fresh, template interface{}, overrides map[string]interface{}) interface{} {
+	switch template := template.(type) {
+	case string:
+		if !strings.Contains(template, "{{") {
+			return fresh
+		}
+		if value, ok := fresh.(string); ok && value == substituteOverridePlaceholders(template, overrides) {
+			return template
+		}
+		return fresh
+	case map[string]interface{}:
+		value, ok := fresh.(map[string]interface{})
+		if !ok {
+			return fresh
+		}
+		for key, entry := range value {
+			if templateEntry, ok := template[key]; ok {
+				value[key] = revertOverriddenWidgetValues(entry, templateEntry, overrides)
+			}
+		}
+		return value
+	case []interface{}:
+		value, ok := fresh.([]interface{})
+		if !ok || len(value) != len(template) {
+			return fresh
+		}
+		for i, entry := range value {
+			value[i] = revertOverriddenWidgetValues(entry, template[i], overrides)
+		}
+		return value
+	default:
+		return fresh
+	}
+}
+
+// applyWidgetQueryOverrides substitutes the configured `{{name}}`
+// placeholders in the built widgets with their override values.
+func applyWidgetQueryOverrides(widgets *[]datadogV1.Widget, overrides map[string]interface{}) error {
+	encoded, err := json.Marshal(widgets)
+	if err != nil {
+		return err
+	}
+	substituted, err := substituteWidgetQueryOverrides(encoded, overrides)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(substituted, widgets)
+}
+
+// getDashboardRaw fetches the raw JSON representation of a dashboard, used to
+// read back fields that the generated client does not expose.
+func getDashboardRaw(providerConf *ProviderConfiguration, id string) (map[string]interface{}, *http.Response, error) {
+	var rawDashboard map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, "/api/v1/dashboard/"+id, nil, &rawDashboard)
+	return rawDashboard, httpresp, err
+}
+
+//
+// Plan-time widget validation
+//
+
+// validateDashboardWidgets performs cross-field widget validation that cannot
+// be expressed in the schema, so invalid combinations are caught at plan time
+// rather than by the API.
+func validateDashboardWidgets(diff *schema.ResourceDiff, meta interface{}) error {
+	terraformWidgets, ok := diff.Get("widget").([]interface{})
+	if !ok {
+		return nil
+	}
+	validator := &widgetValidator{}
+	if providerConf, ok := meta.(*ProviderConfiguration); ok {
+		validator.providerConf = providerConf
+	}
+	if err := validateConditionalFormatTree(terraformWidgets); err != nil {
+		return err
+	}
+	if err := validateComputeTree(terraformWidgets); err != nil {
+		return err
+	}
+	if err := validateProcessQueryTree(terraformWidgets); err != nil {
+		return err
+	}
+	if err := validateApmStatsQueryTree(terraformWidgets); err != nil {
+		return err
+	}
+	if err := validateLiveSpanTree(terraformWidgets); err != nil {
+		return err
+	}
+	if validator.providerConf != nil {
+		if err := validateWidgetURLTree(terraformWidgets, validator.providerConf.widgetURLAllowlist); err != nil {
+			return err
+		}
+	}
+	return validator.validateWidgetList(terraformWidgets)
+}
+
+// dashboardBannerMarker starts the banner appended to the description of
+// dashboards using managed_by_banner. It doubles as the cut point when the
+// banner is stripped on read.
+const dashboardBannerMarker = "\n\n---\nManaged by Terraform"
+
+// appendDashboardBanner appends the managed-by-Terraform banner to a
+// dashboard description.
+func appendDashboardBanner(description, banner string) string {
+	return fmt.Sprintf("%s%s (%s). Manual edits will be overwritten.", description, dashboardBannerMarker, banner)
+}
+
+// stripDashboardBanner removes the managed-by-Terraform banner from a
+// dashboard description, so the state matches the configured description.
+func stripDashboardBanner(description string) string {
+	if idx := strings.Index(description, dashboardBannerMarker); idx != -1 {
+		return description[:idx]
+	}
+	return description
+}
+
+// countDashboardWidgets counts the widgets of a dashboard configuration,
+// including widgets nested in group widgets.
+func countDashboardWidgets(terraformWidgets []interface{}) int {
+	count := 0
+	for _, w := range terraformWidgets {
+		widget, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		count++
+		groups, _ := widget["group_definition"].([]interface{})
+		for _, g := range groups {
+			if group, ok := g.(map[string]interface{}); ok {
+				if nested, ok := group["widget"].([]interface{}); ok {
+					count += countDashboardWidgets(nested)
+				}
+			}
+		}
+	}
+	return count
+}
+
+// validateWidgetURLTree recursively walks the widget configuration and checks
+// iframe and image widget URLs against the provider-level allowlist, so
+// dashboards embedding content from unapproved domains fail during plan.
+func validateWidgetURLTree(v interface{}, allowlist []*regexp.Regexp) error {
+	if len(allowlist) == 0 {
+		return nil
+	}
+	switch value := v.(type) {
+	case []interface{}:
+		for _, item := range value {
+			if err := validateWidgetURLTree(item, allowlist); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		for key, item := range value {
+			if key == "iframe_definition" || key == "image_definition" {
+				definitions, _ := item.([]interface{})
+				for _, def := range definitions {
+					definition, ok := def.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					url, _ := definition["url"].(string)
+					if url == "" {
+						continue
+					}
+					allowed := false
+					for _, pattern := range allowlist {
+						if pattern.MatchString(url) {
+							allowed = true
+							break
+						}
+					}
+					if !allowed {
+						return fmt.Errorf("%s url %q does not match any widget_url_allowlist pattern", key, url)
+					}
+				}
+			}
+			if err := validateWidgetURLTree(item, allowlist); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// widgetsSupportingAlertLiveSpan are the widget types that accept the `alert`
+// live span; every other widget rejects it.
+var widgetsSupportingAlertLiveSpan = map[string]struct{}{
+	"alert_graph_definition": {},
+	"alert_value_definition": {},
+}
+
+// widgetLiveSpanRestrictions lists further live_span values individual widget
+// types reject; the API only answers with a generic 400 for them.
+var widgetLiveSpanRestrictions = map[string][]string{
+	"event_stream_definition":   {"1m"},
+	"event_timeline_definition": {"1m"},
+	"log_stream_definition":     {"1m"},
+}
+
+// validateLiveSpanTree recursively walks the widget configuration and checks
+// that each widget only uses a live_span value its type supports.
+func validateLiveSpanTree(v interface{}) error {
+	switch value := v.(type) {
+	case []interface{}:
+		for _, item := range value {
+			if err := validateLiveSpanTree(item); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		for key, item := range value {
+			if strings.HasSuffix(key, "_definition") {
+				definitions, _ := item.([]interface{})
+				for _, def := range definitions {
+					definition, ok := def.(map[string]interface{})
+					if !ok {
+						continue
+					}
+					if err := validateWidgetLiveSpan(key, definition); err != nil {
+						return err
+					}
+				}
+			}
+			if err := validateLiveSpanTree(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateWidgetLiveSpan(definitionKey string, definition map[string]interface{}) error {
+	time, _ := definition["time"].(map[string]interface{})
+	liveSpan, _ := time["live_span"].(string)
+	if liveSpan == "" {
+		return nil
+	}
+	if liveSpan == "alert" {
+		if _, ok := widgetsSupportingAlertLiveSpan[definitionKey]; !ok {
+			return fmt.Errorf("live_span \"alert\" is only supported by alert widgets, not %s", definitionKey)
+		}
+	}
+	for _, rejected := range widgetLiveSpanRestrictions[definitionKey] {
+		if liveSpan == rejected {
+			return fmt.Errorf("live_span %q is not supported by %s", liveSpan, definitionKey)
+		}
+	}
+	return nil
+}
+
+// validateApmStatsQueryTree recursively walks the widget configuration and
+// checks that at most one column of an APM stats query sets `order`, as the
+// API rejects requests sorted by more than one column.
+func validateApmStatsQueryTree(v interface{}) error {
+	switch value := v.(type) {
+	case []interface{}:
+		for _, item := range value {
+			if err := validateApmStatsQueryTree(item); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		if apmStatsQueries, ok := value["apm_stats_query"].([]interface{}); ok {
+			for _, q := range apmStatsQueries {
+				apmStatsQuery, ok := q.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				ordered := 0
+				columns, _ := apmStatsQuery["columns"].([]interface{})
+				for _, c := range columns {
+					if column, ok := c.(map[string]interface{}); ok {
+						if order, _ := column["order"].(string); order != "" {
+							ordered++
+						}
+					}
+				}
+				if ordered > 1 {
+					return fmt.Errorf("only one column of an apm_stats_query can set order, found %d", ordered)
+				}
+			}
+		}
+		for _, item := range value {
+			if err := validateApmStatsQueryTree(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateProcessQueryTree recursively walks the widget configuration and
+// checks that process queries do not set both a legacy filter attribute and
+// its new process widget format alias.
+func validateProcessQueryTree(v interface{}) error {
+	switch value := v.(type) {
+	case []interface{}:
+		for _, item := range value {
+			if err := validateProcessQueryTree(item); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		if processQueries, ok := value["process_query"].([]interface{}); ok {
+			for _, q := range processQueries {
+				processQuery, ok := q.(map[string]interface{})
+				if !ok {
+					continue
+				}
+				searchBy, _ := processQuery["search_by"].(string)
+				queryFilter, _ := processQuery["query_filter"].(string)
+				if searchBy != "" && queryFilter != "" && searchBy != queryFilter {
+					return fmt.Errorf("only one of search_by or query_filter can be set in a process query, not both")
+				}
+				filterBy, _ := processQuery["filter_by"].([]interface{})
+				tagFilters, _ := processQuery["tag_filters"].([]interface{})
+				if len(filterBy) > 0 && len(tagFilters) > 0 && !reflect.DeepEqual(filterBy, tagFilters) {
+					return fmt.Errorf("only one of filter_by or tag_filters can be set in a process query, not both")
+				}
+			}
+		}
+		for _, item := range value {
+			if err := validateProcessQueryTree(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateComputeTree recursively walks the widget configuration and checks
+// that APM, log, network, RUM and security queries set exactly one of
+// `compute` or `multi_compute`.
+func validateComputeTree(v interface{}) error {
+	switch value := v.(type) {
+	case []interface{}:
+		for _, item := range value {
+			if err := validateComputeTree(item); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		compute, hasCompute := value["compute"].(map[string]interface{})
+		multiCompute, hasMultiCompute := value["multi_compute"].([]interface{})
+		if hasCompute && hasMultiCompute && len(compute) > 0 && len(multiCompute) > 0 {
+			return fmt.Errorf("exactly one of compute or multi_compute must be set in a query, not both")
+		}
+		for _, item := range value {
+			if err := validateComputeTree(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateConditionalFormatTree recursively walks the widget configuration and
+// checks the interplay between the palette of conditional formats and the
+// custom colors, which the UI silently ignores when inconsistent.
+func validateConditionalFormatTree(v interface{}) error {
+	switch value := v.(type) {
+	case []interface{}:
+		for _, item := range value {
+			if err := validateConditionalFormatTree(item); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		if conditionalFormats, ok := value["conditional_formats"].([]interface{}); ok {
+			for _, c := range conditionalFormats {
+				if conditionalFormat, ok := c.(map[string]interface{}); ok {
+					if err := validateConditionalFormat(conditionalFormat); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		for _, item := range value {
+			if err := validateConditionalFormatTree(item); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func validateConditionalFormat(conditionalFormat map[string]interface{}) error {
+	palette, _ := conditionalFormat["palette"].(string)
+	customBgColor, _ := conditionalFormat["custom_bg_color"].(string)
+	customFgColor, _ := conditionalFormat["custom_fg_color"].(string)
+	imageURL, _ := conditionalFormat["image_url"].(string)
+	if palette == "custom_bg" && customBgColor == "" {
+		return fmt.Errorf("conditional formats using the 'custom_bg' palette require custom_bg_color")
+	}
+	if palette == "custom_text" && customFgColor == "" {
+		return fmt.Errorf("conditional formats using the 'custom_text' palette require custom_fg_color")
+	}
+	if palette == "custom_image" && imageURL == "" {
+		return fmt.Errorf("conditional formats using the 'custom_image' palette require image_url")
+	}
+	if customBgColor != "" && palette != "custom_bg" {
+		return fmt.Errorf("custom_bg_color is only supported by conditional formats using the 'custom_bg' palette")
+	}
+	if customFgColor != "" && palette != "custom_text" {
+		return fmt.Errorf("custom_fg_color is only supported by conditional formats using the 'custom_text' palette")
+	}
+	return nil
+}
+
+// widgetValidator carries the provider configuration for the validations that
+// need to call the API, and caches the lookups made while walking the widgets.
+type widgetValidator struct {
+	providerConf     *ProviderConfiguration
+	apmServicesByEnv map[string]map[string]struct{}
+}
+
+func (v *widgetValidator) validateWidgetList(terraformWidgets []interface{}) error {
+	for _, w := range terraformWidgets {
+		widget, ok := w.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, group := range widgetDefinitionList(widget, "group_definition") {
+			if subWidgets, ok := group["widget"].([]interface{}); ok {
+				if err := v.validateWidgetList(subWidgets); err != nil {
+					return err
+				}
+			}
+		}
+		for _, definition := range widgetDefinitionList(widget, "hostmap_definition") {
+			if err := validateHostmapDefinition(definition); err != nil {
+				return err
+			}
+		}
+		for _, definition := range widgetDefinitionList(widget, "check_status_definition") {
+			if err := validateCheckStatusDefinition(definition); err != nil {
+				return err
+			}
+		}
+		for _, definition := range widgetDefinitionList(widget, "trace_service_definition") {
+			if err := v.validateTraceServiceDefinition(definition); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateTraceServiceDefinition validates the env and service of the widget
+// against APM to catch typos at plan time. The check is opt-in through the
+// validate_apm_widget_queries provider setting.
+func (v *widgetValidator) validateTraceServiceDefinition(definition map[string]interface{}) error {
+	if v.providerConf == nil || !v.providerConf.validateApmWidgets {
+		return nil
+	}
+	env, _ := definition["env"].(string)
+	service, _ := definition["service"].(string)
+	if env == "" || service == "" {
+		return nil
+	}
+	services, err := v.apmServices(env)
+	if err != nil {
+		// Unavailability of the validation endpoint should not fail the plan
+		log.Printf("[DEBUG] Skipping APM widget validation, got error listing services: %v", err)
+		return nil
+	}
+	if _, ok := services[service]; !ok {
+		return fmt.Errorf("service %q was not found in APM environment %q (checked because validate_apm_widget_queries is enabled)", service, env)
+	}
+	return nil
+}
+
+func (v *widgetValidator) apmServices(env string) (map[string]struct{}, error) {
+	if services, ok := v.apmServicesByEnv[env]; ok {
+		return services, nil
+	}
+	var dependencies map[string]interface{}
+	if _, err := sendRequest(v.providerConf, http.MethodGet, "/api/v1/service_dependencies?env="+url.QueryEscape(env), nil, &dependencies); err != nil {
+		return nil, err
+	}
+	services := make(map[string]struct{}, len(dependencies))
+	for service := range dependencies {
+		services[service] = struct{}{}
+	}
+	if v.apmServicesByEnv == nil {
+		v.apmServicesByEnv = make(map[string]map[string]struct{})
+	}
+	v.apmServicesByEnv[env] = services
+	return services, nil
+}
+
+// validateCheckStatusDefinition checks the interplay between `grouping`,
+// `group` and `group_by`, which the API only rejects at submit time.
+func validateCheckStatusDefinition(definition map[string]interface{}) error {
+	grouping, _ := definition["grouping"].(string)
+	group, _ := definition["group"].(string)
+	groupBy, _ := definition["group_by"].([]interface{})
+	switch grouping {
+	case "check":
+		if len(groupBy) > 0 {
+			return fmt.Errorf("group_by is only supported by check_status widgets with grouping 'cluster'")
+		}
+	case "cluster":
+		if len(group) > 0 {
+			return fmt.Errorf("group is only supported by check_status widgets with grouping 'check'")
+		}
+	}
+	return nil
+}
+
+// validateHostmapDefinition checks options that only apply to a specific
+// node_type, so container maps fail at plan rather than rendering incorrectly.
+func validateHostmapDefinition(definition map[string]interface{}) error {
+	nodeType, _ := definition["node_type"].(string)
+	if nodeType != "container" {
+		return nil
+	}
+	if v, ok := definition["no_metric_hosts"].(bool); ok && v {
+		return fmt.Errorf("no_metric_hosts is only supported by hostmap widgets with node_type 'host'")
+	}
+	if v, ok := definition["no_group_hosts"].(bool); ok && v {
+		return fmt.Errorf("no_group_hosts is only supported by hostmap widgets with node_type 'host'")
+	}
+	return nil
+}
@@ -76,7 +76,7 @@ var datadogDashboardCheckStatusAsserts = []string{
 	"widget.0.check_status_definition.0.group =",
 	"title = {{uniq}}",
 	"widget.0.check_status_definition.0.grouping = cluster",
-	"widget.0.check_status_definition.0.tags.0 = account:prod",
+	"widget.0.check_status_definition.0.tags.# = 1",
 	"layout_type = ordered",
 	"description = Created using the Datadog provider in Terraform",
 }
@@ -0,0 +1,185 @@
+package datadog
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// Fields of the raw dashboard JSON that identify the source dashboard and
+// must not be copied to the destination org.
+var dashboardCopyStrippedFields = []string{"id", "url", "author_handle", "author_name", "created_at", "modified_at"}
+
+func resourceDatadogDashboardCopy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog dashboard copy resource. It reads a dashboard from another org (e.g. staging) and keeps a clone in sync in the org the provider is configured for (e.g. prod), re-copying whenever the source dashboard changes.",
+		Create:      resourceDatadogDashboardCopyCreate,
+		Read:        resourceDatadogDashboardCopyRead,
+		Update:      resourceDatadogDashboardCopyUpdate,
+		Delete:      resourceDatadogDashboardCopyDelete,
+		CustomizeDiff: func(diff *schema.ResourceDiff, meta interface{}) error {
+			// Detect source changes at plan time so the clone gets
+			// re-copied on apply.
+			if diff.Id() == "" {
+				return nil
+			}
+			providerConf, ok := meta.(*ProviderConfiguration)
+			if !ok {
+				return nil
+			}
+			_, hash, err := getDashboardCopySource(providerConf, diffResourceDataLike{diff})
+			if err != nil {
+				return fmt.Errorf("error reading source dashboard: %s", err.Error())
+			}
+			if hash != diff.Get("source_dashboard_hash").(string) {
+				return diff.SetNew("source_dashboard_hash", hash)
+			}
+			return nil
+		},
+
+		Schema: map[string]*schema.Schema{
+			"source_dashboard_id": {
+				Description: "ID of the dashboard to copy from the source org.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"source_api_key": {
+				Description: "API key of the source org.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"source_app_key": {
+				Description: "APP key of the source org.",
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+			},
+			"source_api_url": {
+				Description: "API URL of the source org, when it lives on a different Datadog site than the provider.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"title": {
+				Description: "Title for the copied dashboard. Defaults to the source dashboard's title.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"source_dashboard_hash": {
+				Description: "Hash of the source dashboard content; changes when the source changes and triggers a re-copy.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// resourceDataLike is the subset of schema.ResourceData that the source
+// fetching helper needs, so it works from both CRUD functions and
+// CustomizeDiff.
+type resourceDataLike interface {
+	Get(string) interface{}
+}
+
+type diffResourceDataLike struct {
+	diff *schema.ResourceDiff
+}
+
+func (d diffResourceDataLike) Get(key string) interface{} {
+	return d.diff.Get(key)
+}
+
+// getDashboardCopySource fetches the raw source dashboard with the source
+// org's credentials, strips org-specific fields and returns it together with
+// a content hash.
+func getDashboardCopySource(providerConf *ProviderConfiguration, d resourceDataLike) (map[string]interface{}, string, error) {
+	// A fresh configuration rather than a copy: ProviderConfiguration holds
+	// a mutex and must not be copied.
+	sourceConf := &ProviderConfiguration{
+		AuthV1:     providerConf.AuthV1,
+		apiKey:     d.Get("source_api_key").(string),
+		appKey:     d.Get("source_app_key").(string),
+		apiURL:     providerConf.apiURL,
+		httpClient: providerConf.httpClient,
+	}
+	if v, ok := d.Get("source_api_url").(string); ok && v != "" {
+		sourceConf.apiURL = v
+	}
+	rawDashboard, _, err := getDashboardRaw(sourceConf, d.Get("source_dashboard_id").(string))
+	if err != nil {
+		return nil, "", err
+	}
+	for _, field := range dashboardCopyStrippedFields {
+		delete(rawDashboard, field)
+	}
+	if title, ok := d.Get("title").(string); ok && title != "" {
+		rawDashboard["title"] = title
+	}
+	// json.Marshal sorts map keys, so the hash is stable.
+	encoded, err := json.Marshal(rawDashboard)
+	if err != nil {
+		return nil, "", err
+	}
+	return rawDashboard, fmt.Sprintf("%x", sha256.Sum256(encoded)), nil
+}
+
+func resourceDatadogDashboardCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	rawDashboard, hash, err := getDashboardCopySource(providerConf, d)
+	if err != nil {
+		return translateClientError(err, "error reading source dashboard")
+	}
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, "/api/v1/dashboard", rawDashboard, &response); err != nil {
+		return translateClientError(err, "error creating dashboard copy")
+	}
+	id, _ := response["id"].(string)
+	d.SetId(id)
+	d.Set("source_dashboard_hash", hash)
+
+	return resourceDatadogDashboardCopyRead(d, meta)
+}
+
+func resourceDatadogDashboardCopyRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, httpresp, err := getDashboardRaw(providerConf, d.Id()); err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting dashboard copy")
+	}
+
+	return nil
+}
+
+func resourceDatadogDashboardCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	rawDashboard, hash, err := getDashboardCopySource(providerConf, d)
+	if err != nil {
+		return translateClientError(err, "error reading source dashboard")
+	}
+	if _, err := sendRequest(providerConf, http.MethodPut, "/api/v1/dashboard/"+d.Id(), rawDashboard, nil); err != nil {
+		return translateClientError(err, "error updating dashboard copy")
+	}
+	d.Set("source_dashboard_hash", hash)
+
+	return resourceDatadogDashboardCopyRead(d, meta)
+}
+
+func resourceDatadogDashboardCopyDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, "/api/v1/dashboard/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting dashboard copy")
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestGetDashboardCopySource(t *testing.T) {
+	widgets := `[]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/dashboard/abc-123" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("DD-API-KEY") != "source-api-key" {
+			t.Errorf("expected the source credentials to be used, got %q", r.Header.Get("DD-API-KEY"))
+		}
+		fmt.Fprintf(w, `{"id": "abc-123", "url": "/dashboard/abc-123", "author_handle": "someone@example.com", "title": "source title", "layout_type": "ordered", "widgets": %s}`, widgets)
+	}))
+	defer server.Close()
+
+	providerConf := testProviderConfForServer(server)
+	d := schema.TestResourceDataRaw(t, resourceDatadogDashboardCopy().Schema, map[string]interface{}{
+		"source_dashboard_id": "abc-123",
+		"source_api_key":      "source-api-key",
+		"source_app_key":      "source-app-key",
+		"source_api_url":      server.URL,
+		"title":               "copied title",
+	})
+
+	rawDashboard, hash, err := getDashboardCopySource(providerConf, d)
+	if err != nil {
+		t.Fatalf("error reading source dashboard: %s", err)
+	}
+
+	for _, field := range dashboardCopyStrippedFields {
+		if _, ok := rawDashboard[field]; ok {
+			t.Errorf("expected %q to be stripped from the copied dashboard", field)
+		}
+	}
+	if rawDashboard["title"] != "copied title" {
+		t.Errorf("expected the configured title to override the source one, got %v", rawDashboard["title"])
+	}
+
+	// The hash only changes when the source content does.
+	_, sameHash, err := getDashboardCopySource(providerConf, d)
+	if err != nil {
+		t.Fatalf("error re-reading source dashboard: %s", err)
+	}
+	if sameHash != hash {
+		t.Error("expected the hash to be stable for unchanged content")
+	}
+	widgets = `[{"definition": {"type": "note", "content": "added"}}]`
+	_, changedHash, err := getDashboardCopySource(providerConf, d)
+	if err != nil {
+		t.Fatalf("error re-reading source dashboard: %s", err)
+	}
+	if changedHash == hash {
+		t.Error("expected the hash to change with the source content")
+	}
+}
@@ -166,23 +166,15 @@ func TestDatadogDashListInDashboard(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testAccCheckDatadogDashListConfigInDashboard(uniqueName),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(
-						"datadog_dashboard.time", "dashboard_lists.#", "1"),
-					resource.TestCheckResourceAttr(
-						"datadog_dashboard.time", "dashboard_lists_removed.#", "0"),
-				),
+				Check: resource.TestCheckResourceAttr(
+					"datadog_dashboard.time", "dashboard_lists.#", "1"),
 				// The plan is non empty, because in this case the list is the same file
 				ExpectNonEmptyPlan: true,
 			},
 			{
 				Config: testAccCheckDatadogDashListConfigRemoveFromDashboard(uniqueName),
-				Check: resource.ComposeTestCheckFunc(
-					resource.TestCheckResourceAttr(
-						"datadog_dashboard.time", "dashboard_lists.#", "0"),
-					resource.TestCheckResourceAttr(
-						"datadog_dashboard.time", "dashboard_lists_removed.#", "1"),
-				),
+				Check: resource.TestCheckResourceAttr(
+					"datadog_dashboard.time", "dashboard_lists.#", "0"),
 			},
 		},
 	})
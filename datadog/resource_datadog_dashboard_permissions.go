@@ -0,0 +1,134 @@
+package datadog
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// dashboardRestrictionPolicyPath returns the restriction policy endpoint for
+// a dashboard; the pinned API client does not cover restriction policies.
+func dashboardRestrictionPolicyPath(dashboardID string) string {
+	return "/api/v2/restriction_policy/dashboard:" + dashboardID
+}
+
+func resourceDatadogDashboardPermissions() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog dashboard permissions resource. It binds roles to a dashboard for viewing or editing, decoupled from the dashboard resource itself, so a central platform team can manage permissions on team-owned dashboards.",
+		Create:      resourceDatadogDashboardPermissionsCreate,
+		Read:        resourceDatadogDashboardPermissionsRead,
+		Update:      resourceDatadogDashboardPermissionsCreate,
+		Delete:      resourceDatadogDashboardPermissionsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"dashboard_id": {
+				Description: "ID of the dashboard the permissions apply to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"editor_role_ids": {
+				Description: "Set of role IDs allowed to edit the dashboard.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"viewer_role_ids": {
+				Description: "Set of role IDs allowed to view the dashboard. An empty set keeps the dashboard visible to the whole org.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func buildDashboardPermissionsBinding(relation string, roleIDs *schema.Set) map[string]interface{} {
+	principals := []string{}
+	for _, roleID := range roleIDs.List() {
+		principals = append(principals, "role:"+roleID.(string))
+	}
+	return map[string]interface{}{
+		"relation":   relation,
+		"principals": principals,
+	}
+}
+
+func resourceDatadogDashboardPermissionsCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	dashboardID := d.Get("dashboard_id").(string)
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "restriction_policy",
+			"id":   "dashboard:" + dashboardID,
+			"attributes": map[string]interface{}{
+				"bindings": []map[string]interface{}{
+					buildDashboardPermissionsBinding("editor", d.Get("editor_role_ids").(*schema.Set)),
+					buildDashboardPermissionsBinding("viewer", d.Get("viewer_role_ids").(*schema.Set)),
+				},
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPost, dashboardRestrictionPolicyPath(dashboardID), payload, nil); err != nil {
+		return translateClientError(err, "error setting dashboard permissions")
+	}
+	d.SetId(dashboardID)
+
+	return resourceDatadogDashboardPermissionsRead(d, meta)
+}
+
+func resourceDatadogDashboardPermissionsRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, dashboardRestrictionPolicyPath(d.Id()), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting dashboard permissions")
+	}
+	d.Set("dashboard_id", d.Id())
+
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	bindings, _ := attributes["bindings"].([]interface{})
+	editorRoleIds := []string{}
+	viewerRoleIds := []string{}
+	for _, b := range bindings {
+		binding, _ := b.(map[string]interface{})
+		principals, _ := binding["principals"].([]interface{})
+		roleIds := []string{}
+		for _, p := range principals {
+			if principal, ok := p.(string); ok && strings.HasPrefix(principal, "role:") {
+				roleIds = append(roleIds, strings.TrimPrefix(principal, "role:"))
+			}
+		}
+		switch binding["relation"] {
+		case "editor":
+			editorRoleIds = append(editorRoleIds, roleIds...)
+		case "viewer":
+			viewerRoleIds = append(viewerRoleIds, roleIds...)
+		}
+	}
+	d.Set("editor_role_ids", editorRoleIds)
+	d.Set("viewer_role_ids", viewerRoleIds)
+
+	return nil
+}
+
+func resourceDatadogDashboardPermissionsDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, dashboardRestrictionPolicyPath(d.Id()), nil, nil); err != nil {
+		return translateClientError(err, "error deleting dashboard permissions")
+	}
+
+	return nil
+}
@@ -0,0 +1,23 @@
+package datadog
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildDashboardPermissionsBinding(t *testing.T) {
+	roleIDs := schema.NewSet(schema.HashString, []interface{}{"role-1", "role-2"})
+
+	binding := buildDashboardPermissionsBinding("editor", roleIDs)
+	if binding["relation"] != "editor" {
+		t.Errorf("unexpected relation %v", binding["relation"])
+	}
+	principals := binding["principals"].([]string)
+	sort.Strings(principals)
+	if !reflect.DeepEqual(principals, []string{"role:role-1", "role:role-2"}) {
+		t.Errorf("unexpected principals: %v", principals)
+	}
+}
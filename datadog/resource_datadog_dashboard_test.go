@@ -224,7 +224,7 @@ resource "datadog_dashboard" "ordered_dashboard" {
 					group_by {
 						facet = "host"
 						limit = 10
-						sort = {
+						sort {
 							aggregation = "avg"
 							order = "desc"
 							facet = "@duration"
@@ -247,7 +247,7 @@ resource "datadog_dashboard" "ordered_dashboard" {
 					group_by {
 						facet = "resource_name"
 						limit = 50
-						sort = {
+						sort {
 							aggregation = "avg"
 							order = "desc"
 							facet = "@string_query.interval"
@@ -808,6 +808,12 @@ var datadogSimpleFreeDashboardAsserts = []string{
 	"template_variable_preset.1.template_variable.0.value = var_1_value",
 }
 
+// stringSetMemberAssert builds the "key = value" assertion for a member of a
+// string TypeSet, whose state key is the hash of its value.
+func stringSetMemberAssert(prefix, value string) string {
+	return fmt.Sprintf("%s.%d = %s", prefix, schema.HashSchema(&schema.Schema{Type: schema.TypeString})(value), value)
+}
+
 var datadogOrderedDashboardAsserts = []string{
 	// Dashboard metadata
 	"description = Created using the Datadog provider in Terraform",
@@ -847,8 +853,8 @@ var datadogOrderedDashboardAsserts = []string{
 	"widget.4.check_status_definition.0.group_by.0 = account",
 	"widget.4.check_status_definition.0.group_by.1 = cluster",
 	"widget.4.check_status_definition.0.tags.# = 2",
-	"widget.4.check_status_definition.0.tags.0 = account:demo",
-	"widget.4.check_status_definition.0.tags.1 = cluster:awseb-ruthebdog-env-8-dn3m6u3gvk",
+	stringSetMemberAssert("widget.4.check_status_definition.0.tags", "account:demo"),
+	stringSetMemberAssert("widget.4.check_status_definition.0.tags", "cluster:awseb-ruthebdog-env-8-dn3m6u3gvk"),
 	"widget.4.check_status_definition.0.title = Widget Title",
 	"widget.4.check_status_definition.0.time.live_span = 1h",
 	// Heatmap widget
@@ -936,9 +942,9 @@ var datadogOrderedDashboardAsserts = []string{
 	"widget.10.timeseries_definition.0.request.1.log_query.0.group_by.# = 1",
 	"widget.10.timeseries_definition.0.request.1.log_query.0.group_by.0.facet = host",
 	"widget.10.timeseries_definition.0.request.1.log_query.0.group_by.0.limit = 10",
-	"widget.10.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.aggregation = avg",
-	"widget.10.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.facet = @duration",
-	"widget.10.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.order = desc",
+	"widget.10.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.0.aggregation = avg",
+	"widget.10.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.0.facet = @duration",
+	"widget.10.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.0.order = desc",
 	"widget.10.timeseries_definition.0.request.1.display_type = area",
 	"widget.10.timeseries_definition.0.request.2.apm_query.0.index = apm-search",
 	"widget.10.timeseries_definition.0.request.2.apm_query.0.compute.aggregation = count",
@@ -948,9 +954,9 @@ var datadogOrderedDashboardAsserts = []string{
 	"widget.10.timeseries_definition.0.request.2.apm_query.0.group_by.# = 1",
 	"widget.10.timeseries_definition.0.request.2.apm_query.0.group_by.0.facet = resource_name",
 	"widget.10.timeseries_definition.0.request.2.apm_query.0.group_by.0.limit = 50",
-	"widget.10.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.aggregation = avg",
-	"widget.10.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.facet = @string_query.interval",
-	"widget.10.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.order = desc",
+	"widget.10.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.0.aggregation = avg",
+	"widget.10.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.0.facet = @string_query.interval",
+	"widget.10.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.0.order = desc",
 	"widget.10.timeseries_definition.0.request.2.display_type = bars",
 	"widget.10.timeseries_definition.0.request.3.process_query.0.metric = process.stat.cpu.total_pct",
 	"widget.10.timeseries_definition.0.request.3.process_query.0.search_by = error",
@@ -1371,3 +1377,54 @@ func testAccDatadogDashboardWidgetUtil_import(t *testing.T, config string, name
 		},
 	})
 }
+
+func TestRevertWidgetQueryOverrides(t *testing.T) {
+	overrides := map[string]interface{}{"env": "prod"}
+	// The prior state's widgets, i.e. the configured template: the override
+	// value also appears verbatim in the widget title.
+	template := []interface{}{
+		map[string]interface{}{
+			"timeseries_definition": []interface{}{
+				map[string]interface{}{
+					"title": "prod overview",
+					"request": []interface{}{
+						map[string]interface{}{"q": "avg:system.cpu.user{env:{{env}}}"},
+						map[string]interface{}{"q": "avg:system.load.1{env:{{env}}}"},
+					},
+				},
+			},
+		},
+	}
+	// The widgets as read back from the API, with the overrides substituted
+	// and the second query edited out of band.
+	fresh := []map[string]interface{}{
+		{
+			"timeseries_definition": []interface{}{
+				map[string]interface{}{
+					"title": "prod overview",
+					"request": []interface{}{
+						map[string]interface{}{"q": "avg:system.cpu.user{env:prod}"},
+						map[string]interface{}{"q": "avg:system.load.5{env:prod}"},
+					},
+				},
+			},
+		},
+	}
+
+	reverted, err := revertWidgetQueryOverrides(&fresh, template, overrides)
+	if err != nil {
+		t.Fatalf("error reverting overrides: %s", err)
+	}
+
+	definition := (*reverted)[0]["timeseries_definition"].([]interface{})[0].(map[string]interface{})
+	if title := definition["title"]; title != "prod overview" {
+		t.Errorf("expected the title to be left alone, got %v", title)
+	}
+	requests := definition["request"].([]interface{})
+	if q := requests[0].(map[string]interface{})["q"]; q != "avg:system.cpu.user{env:{{env}}}" {
+		t.Errorf("expected the substituted query to regain its placeholder, got %v", q)
+	}
+	if q := requests[1].(map[string]interface{})["q"]; q != "avg:system.load.5{env:prod}" {
+		t.Errorf("expected the out-of-band edit to surface as drift, got %v", q)
+	}
+}
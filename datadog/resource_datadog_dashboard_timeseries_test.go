@@ -268,7 +268,7 @@ resource "datadog_dashboard" "timeseries_dashboard" {
 					}
 					group_by {
 						facet = "service"
-						sort = {
+						sort {
 							aggregation = "count"
 							order = "desc"
 						}
@@ -293,7 +293,7 @@ resource "datadog_dashboard" "timeseries_dashboard" {
 					}
 					group_by {
 						facet = "status"
-						sort = {
+						sort {
 							facet = "env"
 							aggregation = "cardinality"
 							order = "desc"
@@ -362,7 +362,7 @@ resource "datadog_dashboard" "timeseries_dashboard" {
 					}
 					group_by {
 						facet = "service"
-						sort = {
+						sort {
 							facet = "@duration"
 							aggregation = "avg"
 							order = "desc"
@@ -484,14 +484,14 @@ var datadogDashboardTimeseriesAsserts = []string{
 	"widget.0.timeseries_definition.0.request.0.on_right_yaxis = true",
 	"widget.0.timeseries_definition.0.request.1.log_query.0.index = *",
 	"widget.0.timeseries_definition.0.request.1.style.# = 1",
-	"widget.0.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.aggregation = count",
+	"widget.0.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.0.aggregation = count",
 	"widget.0.timeseries_definition.0.request.1.style.0.line_width = normal",
 	"widget.0.timeseries_definition.0.request.1.log_query.0.search.query =",
 	"widget.0.timeseries_definition.0.request.1.style.0.palette = cool",
 	"widget.0.timeseries_definition.0.request.1.log_query.0.compute.% = 1",
 	"widget.0.timeseries_definition.0.request.1.log_query.0.group_by.0.facet = service",
 	"widget.0.timeseries_definition.0.request.1.log_query.0.compute.aggregation = count",
-	"widget.0.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.order = desc",
+	"widget.0.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.0.order = desc",
 	"widget.0.timeseries_definition.0.request.1.metadata.# = 0",
 	"widget.0.timeseries_definition.0.request.1.q =",
 	"widget.0.timeseries_definition.0.request.1.log_query.0.search.% = 1",
@@ -499,7 +499,7 @@ var datadogDashboardTimeseriesAsserts = []string{
 	"widget.0.timeseries_definition.0.request.1.log_query.0.group_by.# = 1",
 	"widget.0.timeseries_definition.0.request.1.log_query.0.group_by.0.limit = 10",
 	"widget.0.timeseries_definition.0.request.1.style.0.line_type = solid",
-	"widget.0.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.% = 2",
+	"widget.0.timeseries_definition.0.request.1.log_query.0.group_by.0.sort.# = 1",
 	"widget.0.timeseries_definition.0.request.1.process_query.# = 0",
 	"widget.0.timeseries_definition.0.request.1.display_type = line",
 	"widget.0.timeseries_definition.0.request.1.log_query.# = 1",
@@ -512,7 +512,7 @@ var datadogDashboardTimeseriesAsserts = []string{
 	"widget.0.timeseries_definition.0.request.2.apm_query.0.group_by.# = 1",
 	"widget.0.timeseries_definition.0.request.2.apm_query.# = 1",
 	"widget.0.timeseries_definition.0.request.2.process_query.# = 0",
-	"widget.0.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.order = desc",
+	"widget.0.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.0.order = desc",
 	"widget.0.timeseries_definition.0.request.2.apm_query.0.search.query =",
 	"widget.0.timeseries_definition.0.request.2.log_query.# = 0",
 	"widget.0.timeseries_definition.0.request.2.apm_query.0.compute.interval = 1000",
@@ -522,13 +522,13 @@ var datadogDashboardTimeseriesAsserts = []string{
 	"widget.0.timeseries_definition.0.request.2.style.0.line_width = thick",
 	"widget.0.timeseries_definition.0.request.2.q =",
 	"widget.0.timeseries_definition.0.request.2.style.0.palette = warm",
-	"widget.0.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.% = 3",
+	"widget.0.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.# = 1",
 	"widget.0.timeseries_definition.0.request.2.apm_query.0.compute.facet = env",
 	"widget.0.timeseries_definition.0.request.2.apm_query.0.group_by.0.limit = 10",
 	"widget.0.timeseries_definition.0.request.2.style.# = 1",
-	"widget.0.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.aggregation = cardinality",
+	"widget.0.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.0.aggregation = cardinality",
 	"widget.0.timeseries_definition.0.request.2.apm_query.0.compute.aggregation = cardinality",
-	"widget.0.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.facet = env",
+	"widget.0.timeseries_definition.0.request.2.apm_query.0.group_by.0.sort.0.facet = env",
 	"widget.0.timeseries_definition.0.request.2.apm_query.0.index = trace-search",
 	"widget.0.timeseries_definition.0.request.2.on_right_yaxis = true",
 	"widget.0.timeseries_definition.0.request.3.log_query.# = 0",
@@ -545,9 +545,9 @@ var datadogDashboardTimeseriesAsserts = []string{
 	"widget.0.timeseries_definition.0.request.3.style.0.palette = purple",
 	"widget.0.timeseries_definition.0.request.3.style.0.line_width = normal",
 	"widget.0.timeseries_definition.0.request.3.on_right_yaxis = true",
-	"widget.0.timeseries_definition.0.request.5.rum_query.0.group_by.0.sort.% = 3",
+	"widget.0.timeseries_definition.0.request.5.rum_query.0.group_by.0.sort.# = 1",
 	"widget.0.timeseries_definition.0.request.4.network_query.0.group_by.0.facet = source_region",
-	"widget.0.timeseries_definition.0.request.4.network_query.0.group_by.1.sort.% = 0",
+	"widget.0.timeseries_definition.0.request.4.network_query.0.group_by.1.sort.# = 0",
 	"widget.0.timeseries_definition.0.request.4.network_query.0.compute.% = 2",
 	"widget.0.timeseries_definition.0.request.4.network_query.0.compute.facet = network.bytes_read",
 	"widget.0.timeseries_definition.0.request.5.rum_query.0.search.% = 1",
@@ -560,10 +560,10 @@ var datadogDashboardTimeseriesAsserts = []string{
 	"widget.0.timeseries_definition.0.request.4.network_query.0.group_by.0.limit = 0",
 	"widget.0.timeseries_definition.0.request.5.display_type = area",
 	"widget.0.timeseries_definition.0.request.4.network_query.0.index = netflow-search",
-	"widget.0.timeseries_definition.0.request.5.rum_query.0.group_by.0.sort.facet = @duration",
+	"widget.0.timeseries_definition.0.request.5.rum_query.0.group_by.0.sort.0.facet = @duration",
 	"widget.0.timeseries_definition.0.request.4.q =",
 	"widget.0.timeseries_definition.0.request.5.rum_query.0.compute.% = 3",
-	"widget.0.timeseries_definition.0.request.5.rum_query.0.group_by.0.sort.aggregation = avg",
+	"widget.0.timeseries_definition.0.request.5.rum_query.0.group_by.0.sort.0.aggregation = avg",
 	"widget.0.timeseries_definition.0.request.5.style.0.line_type = solid",
 	"widget.0.timeseries_definition.0.request.5.rum_query.0.group_by.0.facet = service",
 	"widget.0.timeseries_definition.0.request.4.style.0.line_type = solid",
@@ -573,13 +573,13 @@ var datadogDashboardTimeseriesAsserts = []string{
 	"widget.0.timeseries_definition.0.request.4.style.0.line_width = normal",
 	"widget.0.timeseries_definition.0.request.4.style.0.palette = orange",
 	"widget.0.timeseries_definition.0.request.4.display_type = area",
-	"widget.0.timeseries_definition.0.request.4.network_query.0.group_by.0.sort.% = 0",
+	"widget.0.timeseries_definition.0.request.4.network_query.0.group_by.0.sort.# = 0",
 	"widget.0.timeseries_definition.0.request.5.style.0.palette = grey",
 	"widget.0.timeseries_definition.0.request.4.network_query.0.compute.aggregation = sum",
 	"widget.0.timeseries_definition.0.request.4.on_right_yaxis = true",
 	"widget.0.timeseries_definition.0.request.5.q =",
 	"widget.0.timeseries_definition.0.request.5.rum_query.0.index = *",
-	"widget.0.timeseries_definition.0.request.5.rum_query.0.group_by.0.sort.order = desc",
+	"widget.0.timeseries_definition.0.request.5.rum_query.0.group_by.0.sort.0.order = desc",
 	"widget.0.timeseries_definition.0.request.5.rum_query.0.search.query =",
 	"widget.0.timeseries_definition.0.request.5.on_right_yaxis = true",
 	"widget.0.timeseries_definition.0.custom_link.# = 1",
@@ -653,7 +653,7 @@ resource "datadog_dashboard" "timeseries_dashboard" {
 					}
 					group_by {
 						facet = "service"
-						sort = {
+						sort {
 							aggregation = "count"
 							order = "desc"
 						}
@@ -701,8 +701,8 @@ var datadogDashboardTimeseriesMultiComputeAsserts = []string{
 	"widget.0.timeseries_definition.0.request.0.log_query.0.group_by.# = 1",
 	"widget.0.timeseries_definition.0.request.0.log_query.0.group_by.0.facet = service",
 	"widget.0.timeseries_definition.0.request.0.log_query.0.group_by.0.limit = 10",
-	"widget.0.timeseries_definition.0.request.0.log_query.0.group_by.0.sort.aggregation = count",
-	"widget.0.timeseries_definition.0.request.0.log_query.0.group_by.0.sort.order = desc",
+	"widget.0.timeseries_definition.0.request.0.log_query.0.group_by.0.sort.0.aggregation = count",
+	"widget.0.timeseries_definition.0.request.0.log_query.0.group_by.0.sort.0.order = desc",
 	"widget.0.timeseries_definition.0.request.0.log_query.0.index = *",
 	"widget.0.timeseries_definition.0.request.0.style.# = 1",
 	"widget.0.timeseries_definition.0.request.0.style.0.line_type = solid",
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -27,6 +28,7 @@ func resourceDatadogDowntime() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: resourceDatadogDowntimeImport,
 		},
+		CustomizeDiff: validateDatadogDowntimeBoundaries,
 
 		Schema: map[string]*schema.Schema{
 			"active": {
@@ -135,6 +137,7 @@ func resourceDatadogDowntime() *schema.Resource {
 							Description:   "The RRULE standard for defining recurring events. For example, to have a recurring event on the first day of each month, use `FREQ=MONTHLY;INTERVAL=1`. Most common rrule options from the iCalendar Spec are supported. Attributes specifying the duration in RRULE are not supported (for example, `DTSTART`, `DTEND`, `DURATION`).",
 							Type:          schema.TypeString,
 							Optional:      true,
+							ValidateFunc:  validateDatadogDowntimeRecurrenceRrule,
 							ConflictsWith: []string{"recurrence.period", "recurrence.until_date", "recurrence.until_occurrences", "recurrence.week_days"},
 						},
 					},
@@ -489,6 +492,87 @@ func validateDatadogDowntimeRecurrenceWeekDays(v interface{}, k string) (ws []st
 	return
 }
 
+// validateDatadogDowntimeBoundaries rejects schedules whose end boundary is
+// not after their start boundary at plan time, instead of letting the API
+// refuse them minutes before a maintenance window.
+func validateDatadogDowntimeBoundaries(diff *schema.ResourceDiff, meta interface{}) error {
+	boundary := func(dateAttr, tsAttr string) int64 {
+		if attr, ok := diff.GetOk(dateAttr); ok {
+			if t, err := time.Parse(time.RFC3339, attr.(string)); err == nil {
+				return t.Unix()
+			}
+		} else if attr, ok := diff.GetOk(tsAttr); ok {
+			return int64(attr.(int))
+		}
+		return 0
+	}
+	start := boundary("start_date", "start")
+	end := boundary("end_date", "end")
+	if start != 0 && end != 0 && end <= start {
+		return fmt.Errorf("downtime end (%d) must be after its start (%d)", end, start)
+	}
+	return nil
+}
+
+// downtimeRruleValueRegexps maps the supported RRULE parts to the pattern
+// their value must match. Duration related parts (DTSTART, DTEND, DURATION)
+// are deliberately absent since the API does not accept them.
+var downtimeRruleValueRegexps = map[string]*regexp.Regexp{
+	"FREQ":       regexp.MustCompile(`^(SECONDLY|MINUTELY|HOURLY|DAILY|WEEKLY|MONTHLY|YEARLY)$`),
+	"INTERVAL":   regexp.MustCompile(`^[0-9]+$`),
+	"COUNT":      regexp.MustCompile(`^[0-9]+$`),
+	"UNTIL":      regexp.MustCompile(`^[0-9]{8}(T[0-9]{6}Z?)?$`),
+	"WKST":       regexp.MustCompile(`^(MO|TU|WE|TH|FR|SA|SU)$`),
+	"BYDAY":      regexp.MustCompile(`^[+-]?[0-9]*(MO|TU|WE|TH|FR|SA|SU)(,[+-]?[0-9]*(MO|TU|WE|TH|FR|SA|SU))*$`),
+	"BYMONTH":    regexp.MustCompile(`^[+-]?[0-9]+(,[+-]?[0-9]+)*$`),
+	"BYMONTHDAY": regexp.MustCompile(`^[+-]?[0-9]+(,[+-]?[0-9]+)*$`),
+	"BYYEARDAY":  regexp.MustCompile(`^[+-]?[0-9]+(,[+-]?[0-9]+)*$`),
+	"BYWEEKNO":   regexp.MustCompile(`^[+-]?[0-9]+(,[+-]?[0-9]+)*$`),
+	"BYHOUR":     regexp.MustCompile(`^[0-9]+(,[0-9]+)*$`),
+	"BYMINUTE":   regexp.MustCompile(`^[0-9]+(,[0-9]+)*$`),
+	"BYSECOND":   regexp.MustCompile(`^[0-9]+(,[0-9]+)*$`),
+	"BYSETPOS":   regexp.MustCompile(`^[+-]?[0-9]+(,[+-]?[0-9]+)*$`),
+}
+
+func validateDatadogDowntimeRecurrenceRrule(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(value, ";") {
+		pieces := strings.SplitN(part, "=", 2)
+		if len(pieces) != 2 || pieces[0] == "" || pieces[1] == "" {
+			errors = append(errors, fmt.Errorf(
+				"%q contains a malformed RRULE part %q, expected KEY=VALUE", k, part))
+			continue
+		}
+		name := strings.ToUpper(pieces[0])
+		if name == "DTSTART" || name == "DTEND" || name == "DURATION" {
+			errors = append(errors, fmt.Errorf(
+				"%q must not contain the duration related RRULE part %q", k, name))
+			continue
+		}
+		pattern, ok := downtimeRruleValueRegexps[name]
+		if !ok {
+			errors = append(errors, fmt.Errorf(
+				"%q contains an unsupported RRULE part %q", k, name))
+			continue
+		}
+		if seen[name] {
+			errors = append(errors, fmt.Errorf(
+				"%q contains the RRULE part %q more than once", k, name))
+			continue
+		}
+		seen[name] = true
+		if !pattern.MatchString(pieces[1]) {
+			errors = append(errors, fmt.Errorf(
+				"%q contains an invalid value %q for RRULE part %q", k, pieces[1], name))
+		}
+	}
+	if !seen["FREQ"] {
+		errors = append(errors, fmt.Errorf("%q must contain a FREQ RRULE part", k))
+	}
+	return
+}
+
 func validateDatadogDowntimeTimezone(v interface{}, k string) (ws []string, errors []error) {
 	value := v.(string)
 	switch strings.ToLower(value) {
@@ -0,0 +1,93 @@
+package datadog
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// errorTrackingAutoAssignmentPath is the Error Tracking auto-assignment
+// settings endpoint, which the pinned API client does not expose.
+const errorTrackingAutoAssignmentPath = "/api/v2/error_tracking/auto_assignment"
+
+func resourceDatadogErrorTrackingAutoAssignment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Error Tracking auto-assignment settings resource. The settings are org wide, so only one instance of this resource is needed.",
+		Create:      resourceDatadogErrorTrackingAutoAssignmentCreate,
+		Read:        resourceDatadogErrorTrackingAutoAssignmentRead,
+		Update:      resourceDatadogErrorTrackingAutoAssignmentUpdate,
+		Delete:      resourceDatadogErrorTrackingAutoAssignmentDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Description: "Whether new issues are automatically assigned to the author of the suspect commit.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func updateErrorTrackingAutoAssignment(providerConf *ProviderConfiguration, enabled bool) error {
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "auto_assignment_settings",
+			"attributes": map[string]interface{}{
+				"enabled": enabled,
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPut, errorTrackingAutoAssignmentPath, payload, nil); err != nil {
+		return translateClientError(err, "error updating error tracking auto-assignment settings")
+	}
+	return nil
+}
+
+func resourceDatadogErrorTrackingAutoAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if err := updateErrorTrackingAutoAssignment(providerConf, d.Get("enabled").(bool)); err != nil {
+		return err
+	}
+	// The settings are an org wide singleton.
+	d.SetId("error-tracking-auto-assignment")
+
+	return resourceDatadogErrorTrackingAutoAssignmentRead(d, meta)
+}
+
+func resourceDatadogErrorTrackingAutoAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, errorTrackingAutoAssignmentPath, nil, &response); err != nil {
+		return translateClientError(err, "error getting error tracking auto-assignment settings")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if enabled, ok := attributes["enabled"].(bool); ok {
+		d.Set("enabled", enabled)
+	}
+
+	return nil
+}
+
+func resourceDatadogErrorTrackingAutoAssignmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if err := updateErrorTrackingAutoAssignment(providerConf, d.Get("enabled").(bool)); err != nil {
+		return err
+	}
+
+	return resourceDatadogErrorTrackingAutoAssignmentRead(d, meta)
+}
+
+func resourceDatadogErrorTrackingAutoAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	// The API has no delete call for the settings; disabling restores the
+	// default behavior.
+	return updateErrorTrackingAutoAssignment(providerConf, false)
+}
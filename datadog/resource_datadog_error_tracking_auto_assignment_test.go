@@ -0,0 +1,40 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestErrorTrackingAutoAssignmentCreate(t *testing.T) {
+	var putBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != errorTrackingAutoAssignmentPath {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Method == http.MethodPut {
+			putBody = decodeTestRequestBody(t, r)
+		}
+		fmt.Fprint(w, `{"data": {"type": "auto_assignment_settings", "attributes": {"enabled": true}}}`)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceDatadogErrorTrackingAutoAssignment().Schema, map[string]interface{}{
+		"enabled": true,
+	})
+	if err := resourceDatadogErrorTrackingAutoAssignmentCreate(d, testProviderConfForServer(server)); err != nil {
+		t.Fatalf("create returned an error: %s", err)
+	}
+
+	if d.Id() != "error-tracking-auto-assignment" {
+		t.Errorf("expected the singleton ID, got %q", d.Id())
+	}
+	data, _ := putBody["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if data["type"] != "auto_assignment_settings" || attributes["enabled"] != true {
+		t.Errorf("unexpected update payload: %v", putBody)
+	}
+}
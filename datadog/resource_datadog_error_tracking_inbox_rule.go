@@ -0,0 +1,136 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// errorTrackingInboxRulesPath is the Error Tracking inbox rules endpoint;
+// the pinned API client has no support for it.
+const errorTrackingInboxRulesPath = "/api/v2/error_tracking/inbox_rules"
+
+func resourceDatadogErrorTrackingInboxRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Error Tracking inbox rule resource, routing matching issues at ingestion so triage routing is code-reviewed.",
+		Create:      resourceDatadogErrorTrackingInboxRuleCreate,
+		Read:        resourceDatadogErrorTrackingInboxRuleRead,
+		Update:      resourceDatadogErrorTrackingInboxRuleUpdate,
+		Delete:      resourceDatadogErrorTrackingInboxRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the inbox rule.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"query": {
+				Description: "The query selecting the issues the rule applies to, e.g. `service:checkout`.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"action": {
+				Description:  "What happens to matching issues. Valid values are `inbox`, `archive`, `ignore`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"inbox", "archive", "ignore"}, false),
+			},
+			"assignee": {
+				Description: "The handle of the user or team matching issues are assigned to.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"enabled": {
+				Description: "Whether the rule is applied.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func buildErrorTrackingInboxRulePayload(d *schema.ResourceData) map[string]interface{} {
+	attributes := map[string]interface{}{
+		"name":     d.Get("name").(string),
+		"query":    d.Get("query").(string),
+		"action":   d.Get("action").(string),
+		"assignee": d.Get("assignee").(string),
+		"enabled":  d.Get("enabled").(bool),
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "inbox_rule",
+			"attributes": attributes,
+		},
+	}
+}
+
+func resourceDatadogErrorTrackingInboxRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, errorTrackingInboxRulesPath, buildErrorTrackingInboxRulePayload(d), &response); err != nil {
+		return translateClientError(err, "error creating error tracking inbox rule")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, ok := data["id"].(string)
+	if !ok {
+		return fmt.Errorf("error creating error tracking inbox rule: no id in response")
+	}
+	d.SetId(id)
+
+	return resourceDatadogErrorTrackingInboxRuleRead(d, meta)
+}
+
+func resourceDatadogErrorTrackingInboxRuleRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, errorTrackingInboxRulesPath+"/"+d.Id(), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting error tracking inbox rule")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	d.Set("name", attributes["name"])
+	d.Set("query", attributes["query"])
+	d.Set("action", attributes["action"])
+	d.Set("assignee", attributes["assignee"])
+	if enabled, ok := attributes["enabled"].(bool); ok {
+		d.Set("enabled", enabled)
+	}
+
+	return nil
+}
+
+func resourceDatadogErrorTrackingInboxRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload := buildErrorTrackingInboxRulePayload(d)
+	payload["data"].(map[string]interface{})["id"] = d.Id()
+	if _, err := sendRequest(providerConf, http.MethodPatch, errorTrackingInboxRulesPath+"/"+d.Id(), payload, nil); err != nil {
+		return translateClientError(err, "error updating error tracking inbox rule")
+	}
+
+	return resourceDatadogErrorTrackingInboxRuleRead(d, meta)
+}
+
+func resourceDatadogErrorTrackingInboxRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, errorTrackingInboxRulesPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting error tracking inbox rule")
+	}
+
+	return nil
+}
@@ -0,0 +1,34 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildErrorTrackingInboxRulePayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogErrorTrackingInboxRule().Schema, map[string]interface{}{
+		"name":     "route checkout issues",
+		"query":    "service:checkout",
+		"action":   "inbox",
+		"assignee": "team-checkout",
+	})
+
+	payload := buildErrorTrackingInboxRulePayload(d)
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "inbox_rule",
+			"attributes": map[string]interface{}{
+				"name":     "route checkout issues",
+				"query":    "service:checkout",
+				"action":   "inbox",
+				"assignee": "team-checkout",
+				"enabled":  true,
+			},
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
@@ -0,0 +1,144 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// eventCorrelationRulesPath is the Event Management correlation rules
+// endpoint, absent from the pinned API client.
+const eventCorrelationRulesPath = "/api/v2/events/correlation/rules"
+
+func resourceDatadogEventCorrelationRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Event Management correlation rule resource, grouping related events into a single alert so noise reduction logic is reviewable.",
+		Create:      resourceDatadogEventCorrelationRuleCreate,
+		Read:        resourceDatadogEventCorrelationRuleRead,
+		Update:      resourceDatadogEventCorrelationRuleUpdate,
+		Delete:      resourceDatadogEventCorrelationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the correlation rule.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"query": {
+				Description: "The query selecting the events the rule correlates.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"enabled": {
+				Description: "Whether the rule is applied.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"group_by": {
+				Description: "Tag keys the selected events are grouped by; events sharing the same values are correlated into one alert.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"time_window": {
+				Description:  "The time window, in seconds, within which events are correlated. Valid values are between `300` and `86400`.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1800,
+				ValidateFunc: validation.IntBetween(300, 86400),
+			},
+		},
+	}
+}
+
+func buildEventCorrelationRulePayload(d *schema.ResourceData) map[string]interface{} {
+	groupBy := []string{}
+	for _, key := range d.Get("group_by").([]interface{}) {
+		groupBy = append(groupBy, key.(string))
+	}
+	attributes := map[string]interface{}{
+		"name":        d.Get("name").(string),
+		"query":       d.Get("query").(string),
+		"enabled":     d.Get("enabled").(bool),
+		"group_by":    groupBy,
+		"time_window": d.Get("time_window").(int),
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "correlation_rule",
+			"attributes": attributes,
+		},
+	}
+}
+
+func resourceDatadogEventCorrelationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, eventCorrelationRulesPath, buildEventCorrelationRulePayload(d), &response); err != nil {
+		return translateClientError(err, "error creating event correlation rule")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, ok := data["id"].(string)
+	if !ok {
+		return fmt.Errorf("error creating event correlation rule: no id in response")
+	}
+	d.SetId(id)
+
+	return resourceDatadogEventCorrelationRuleRead(d, meta)
+}
+
+func resourceDatadogEventCorrelationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, eventCorrelationRulesPath+"/"+d.Id(), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting event correlation rule")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	d.Set("name", attributes["name"])
+	d.Set("query", attributes["query"])
+	if enabled, ok := attributes["enabled"].(bool); ok {
+		d.Set("enabled", enabled)
+	}
+	d.Set("group_by", attributes["group_by"])
+	if timeWindow, ok := attributes["time_window"].(float64); ok {
+		d.Set("time_window", int(timeWindow))
+	}
+
+	return nil
+}
+
+func resourceDatadogEventCorrelationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload := buildEventCorrelationRulePayload(d)
+	payload["data"].(map[string]interface{})["id"] = d.Id()
+	if _, err := sendRequest(providerConf, http.MethodPatch, eventCorrelationRulesPath+"/"+d.Id(), payload, nil); err != nil {
+		return translateClientError(err, "error updating event correlation rule")
+	}
+
+	return resourceDatadogEventCorrelationRuleRead(d, meta)
+}
+
+func resourceDatadogEventCorrelationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, eventCorrelationRulesPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting event correlation rule")
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildEventCorrelationRulePayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogEventCorrelationRule().Schema, map[string]interface{}{
+		"name":     "group by service",
+		"query":    "source:alert",
+		"group_by": []interface{}{"service", "env"},
+	})
+
+	payload := buildEventCorrelationRulePayload(d)
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "correlation_rule",
+			"attributes": map[string]interface{}{
+				"name":        "group by service",
+				"query":       "source:alert",
+				"enabled":     true,
+				"group_by":    []string{"service", "env"},
+				"time_window": 1800,
+			},
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
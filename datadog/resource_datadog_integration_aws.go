@@ -1,7 +1,10 @@
 package datadog
 
 import (
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/url"
 	"os"
 	"strings"
 	"sync"
@@ -70,6 +73,30 @@ func resourceDatadogIntegrationAws() *schema.Resource {
 				Description: "AWS External ID. **NOTE** This provider will not be able to detect changes made to the `external_id` field from outside Terraform.",
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
+			},
+			"metrics_collection_enabled": {
+				Description: "Whether Datadog collects metrics for this AWS account.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"resource_collection_enabled": {
+				Description: "Whether Datadog collects a standard set of resources from your AWS account.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"cspm_resource_collection_enabled": {
+				Description: "Whether Datadog collects cloud security posture management resources from your AWS account.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+			},
+			"regenerate_external_id": {
+				Description: "An arbitrary string. Changing its value generates a new AWS External ID for this account, so the role trust policy can be rotated via Terraform.",
+				Type:        schema.TypeString,
+				Optional:    true,
 			},
 		},
 	}
@@ -116,10 +143,37 @@ func buildDatadogIntegrationAwsStruct(d *schema.ResourceData, accountID string,
 	return iaws
 }
 
+// integrationAwsCollectionFlags lists the collection toggles the pinned API
+// client does not model yet; they are sent and read through raw requests.
+var integrationAwsCollectionFlags = []string{
+	"metrics_collection_enabled",
+	"resource_collection_enabled",
+	"cspm_resource_collection_enabled",
+}
+
+// buildDatadogIntegrationAwsPayload returns the raw request payload for the
+// account: the generated struct plus the collection flags the pinned client
+// does not model.
+func buildDatadogIntegrationAwsPayload(d *schema.ResourceData, accountID string, roleName string) (map[string]interface{}, error) {
+	iaws := buildDatadogIntegrationAwsStruct(d, accountID, roleName)
+	marshaled, err := json.Marshal(iaws)
+	if err != nil {
+		return nil, err
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(marshaled, &payload); err != nil {
+		return nil, err
+	}
+	for _, flag := range integrationAwsCollectionFlags {
+		if v, ok := d.GetOkExists(flag); ok {
+			payload[flag] = v.(bool)
+		}
+	}
+	return payload, nil
+}
+
 func resourceDatadogIntegrationAwsCreate(d *schema.ResourceData, meta interface{}) error {
 	providerConf := meta.(*ProviderConfiguration)
-	datadogClientV1 := providerConf.DatadogClientV1
-	authV1 := providerConf.AuthV1
 
 	integrationAwsMutex.Lock()
 	defer integrationAwsMutex.Unlock()
@@ -127,15 +181,19 @@ func resourceDatadogIntegrationAwsCreate(d *schema.ResourceData, meta interface{
 	accountID := d.Get("account_id").(string)
 	roleName := d.Get("role_name").(string)
 
-	iaws := buildDatadogIntegrationAwsStruct(d, accountID, roleName)
-	response, _, err := datadogClientV1.AWSIntegrationApi.CreateAWSAccount(authV1).Body(*iaws).Execute()
-
+	payload, err := buildDatadogIntegrationAwsPayload(d, accountID, roleName)
 	if err != nil {
+		return err
+	}
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, "/api/v1/integration/aws", payload, &response); err != nil {
 		return translateClientError(err, "error creating AWS integration")
 	}
 
 	d.SetId(fmt.Sprintf("%s:%s", accountID, roleName))
-	d.Set("external_id", response.ExternalId)
+	if externalID, ok := response["external_id"].(string); ok {
+		d.Set("external_id", externalID)
+	}
 
 	return resourceDatadogIntegrationAwsRead(d, meta)
 }
@@ -163,7 +221,7 @@ func resourceDatadogIntegrationAwsRead(d *schema.ResourceData, meta interface{})
 			d.Set("host_tags", integration.GetHostTags())
 			d.Set("account_specific_namespace_rules", integration.GetAccountSpecificNamespaceRules())
 			d.Set("excluded_regions", integration.GetExcludedRegions())
-			return nil
+			return readDatadogIntegrationAwsCollectionFlags(d, providerConf, accountID, roleName)
 		}
 	}
 
@@ -171,6 +229,29 @@ func resourceDatadogIntegrationAwsRead(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+// readDatadogIntegrationAwsCollectionFlags loads the collection toggles the
+// pinned API client drops from the account list response.
+func readDatadogIntegrationAwsCollectionFlags(d *schema.ResourceData, providerConf *ProviderConfiguration, accountID, roleName string) error {
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, "/api/v1/integration/aws", nil, &response); err != nil {
+		return translateClientError(err, "error getting AWS integration")
+	}
+	accounts, _ := response["accounts"].([]interface{})
+	for _, a := range accounts {
+		account, ok := a.(map[string]interface{})
+		if !ok || account["account_id"] != accountID || account["role_name"] != roleName {
+			continue
+		}
+		for _, flag := range integrationAwsCollectionFlags {
+			if v, ok := account[flag].(bool); ok {
+				d.Set(flag, v)
+			}
+		}
+		return nil
+	}
+	return nil
+}
+
 func resourceDatadogIntegrationAwsUpdate(d *schema.ResourceData, meta interface{}) error {
 	providerConf := meta.(*ProviderConfiguration)
 	datadogClientV1 := providerConf.DatadogClientV1
@@ -185,13 +266,27 @@ func resourceDatadogIntegrationAwsUpdate(d *schema.ResourceData, meta interface{
 	newAccountID := d.Get("account_id").(string)
 	newRoleName := d.Get("role_name").(string)
 
-	iaws := buildDatadogIntegrationAwsStruct(d, newAccountID, newRoleName)
-	_, _, err = datadogClientV1.AWSIntegrationApi.UpdateAWSAccount(authV1).
-		Body(*iaws).AccountId(existingAccountID).RoleName(existingRoleName).Execute()
+	payload, err := buildDatadogIntegrationAwsPayload(d, newAccountID, newRoleName)
 	if err != nil {
+		return err
+	}
+	query := url.Values{}
+	query.Set("account_id", existingAccountID)
+	query.Set("role_name", existingRoleName)
+	if _, err := sendRequest(providerConf, http.MethodPut, "/api/v1/integration/aws?"+query.Encode(), payload, nil); err != nil {
 		return translateClientError(err, "error updating AWS integration")
 	}
-	d.SetId(fmt.Sprintf("%s:%s", iaws.GetAccountId(), iaws.GetRoleName()))
+	d.SetId(fmt.Sprintf("%s:%s", newAccountID, newRoleName))
+
+	if d.HasChange("regenerate_external_id") {
+		iaws := buildDatadogIntegrationAwsStruct(d, newAccountID, newRoleName)
+		response, _, err := datadogClientV1.AWSIntegrationApi.CreateNewAWSExternalID(authV1).Body(*iaws).Execute()
+		if err != nil {
+			return translateClientError(err, "error generating a new external ID for AWS integration")
+		}
+		d.Set("external_id", response.GetExternalId())
+	}
+
 	return resourceDatadogIntegrationAwsRead(d, meta)
 }
 
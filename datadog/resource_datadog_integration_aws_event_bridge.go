@@ -0,0 +1,186 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// integrationAwsEventBridgePath is the AWS EventBridge integration endpoint,
+// not present in the pinned API client.
+const integrationAwsEventBridgePath = "/api/v1/integration/aws/event_bridge"
+
+func accountRegionAndGeneratorFromID(id string) (string, string, string, error) {
+	result := strings.SplitN(id, ":", 3)
+	if len(result) != 3 {
+		return "", "", "", fmt.Errorf("error extracting account ID, region and event generator name from an AWS EventBridge integration id: %s", id)
+	}
+	return result[0], result[1], result[2], nil
+}
+
+// matchEventSourceName reports whether an event source name read from the API
+// belongs to this resource. When the full name is already known from state it
+// is matched exactly. Otherwise the name must be the generator name followed
+// by a single assigned suffix, so a generator name that is a prefix of
+// another one ("app" vs "app-alerts") cannot adopt the other one's source.
+func matchEventSourceName(name, eventGeneratorName, eventSourceName string) bool {
+	if eventSourceName != "" {
+		return name == eventSourceName
+	}
+	if !strings.HasPrefix(name, eventGeneratorName+"-") {
+		return false
+	}
+	return !strings.Contains(strings.TrimPrefix(name, eventGeneratorName+"-"), "-")
+}
+
+func resourceDatadogIntegrationAwsEventBridge() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog - Amazon Web Services integration EventBridge resource. This can be used to create and manage event sources for an AWS account, so event source creation can be wired into the same apply that creates the EventBridge rules.\n\nUpdate operations are currently not supported with datadog API so any change forces a new resource.",
+		Create:      resourceDatadogIntegrationAwsEventBridgeCreate,
+		Read:        resourceDatadogIntegrationAwsEventBridgeRead,
+		Delete:      resourceDatadogIntegrationAwsEventBridgeDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceDatadogIntegrationAwsEventBridgeImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_id": {
+				Description: "Your AWS Account ID without dashes.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // waits for update API call support
+			},
+			"region": {
+				Description: "The AWS region the event source lives in.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // waits for update API call support
+			},
+			"event_generator_name": {
+				Description: "The given part of the event source name, which is then combined with an assigned suffix to form the full name.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // waits for update API call support
+			},
+			"create_event_bus": {
+				Description: "True if Datadog should create the event bus in addition to the event source. Requires the `events:CreateEventBus` permission.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true, // waits for update API call support
+			},
+			"event_source_name": {
+				Description: "The event source name attributed by Datadog, including the assigned suffix.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceDatadogIntegrationAwsEventBridgeCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	integrationAwsMutex.Lock()
+	defer integrationAwsMutex.Unlock()
+
+	accountID := d.Get("account_id").(string)
+	region := d.Get("region").(string)
+	eventGeneratorName := d.Get("event_generator_name").(string)
+
+	payload := map[string]interface{}{
+		"account_id":           accountID,
+		"region":               region,
+		"event_generator_name": eventGeneratorName,
+		"create_event_bus":     d.Get("create_event_bus").(bool),
+	}
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, integrationAwsEventBridgePath, payload, &response); err != nil {
+		return translateClientError(err, "error creating AWS EventBridge integration")
+	}
+
+	d.SetId(fmt.Sprintf("%s:%s:%s", accountID, region, eventGeneratorName))
+	if eventSourceName, ok := response["event_source_name"].(string); ok {
+		d.Set("event_source_name", eventSourceName)
+	}
+
+	return resourceDatadogIntegrationAwsEventBridgeRead(d, meta)
+}
+
+func resourceDatadogIntegrationAwsEventBridgeRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	accountID, region, eventGeneratorName, err := accountRegionAndGeneratorFromID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, integrationAwsEventBridgePath, nil, &response); err != nil {
+		return translateClientError(err, "error getting AWS EventBridge integration")
+	}
+
+	eventSourceName, _ := d.Get("event_source_name").(string)
+	accounts, _ := response["accounts"].([]interface{})
+	for _, a := range accounts {
+		account, ok := a.(map[string]interface{})
+		if !ok || account["account_id"] != accountID {
+			continue
+		}
+		sources, _ := account["event_hubs"].([]interface{})
+		for _, s := range sources {
+			source, ok := s.(map[string]interface{})
+			if !ok || source["region"] != region {
+				continue
+			}
+			// The API appends an assigned suffix to the configured name, so
+			// sources are matched on the suffix boundary unless the full
+			// name is already known from state.
+			if name, ok := source["name"].(string); ok && matchEventSourceName(name, eventGeneratorName, eventSourceName) {
+				d.Set("account_id", accountID)
+				d.Set("region", region)
+				d.Set("event_generator_name", eventGeneratorName)
+				d.Set("event_source_name", name)
+				return nil
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceDatadogIntegrationAwsEventBridgeDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	integrationAwsMutex.Lock()
+	defer integrationAwsMutex.Unlock()
+
+	accountID, region, eventGeneratorName, err := accountRegionAndGeneratorFromID(d.Id())
+	if err != nil {
+		return err
+	}
+	if eventSourceName, ok := d.GetOk("event_source_name"); ok {
+		eventGeneratorName = eventSourceName.(string)
+	}
+
+	payload := map[string]interface{}{
+		"account_id":           accountID,
+		"region":               region,
+		"event_generator_name": eventGeneratorName,
+	}
+	if _, err := sendRequest(providerConf, http.MethodDelete, integrationAwsEventBridgePath, payload, nil); err != nil {
+		return translateClientError(err, "error deleting AWS EventBridge integration")
+	}
+
+	return nil
+}
+
+func resourceDatadogIntegrationAwsEventBridgeImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := resourceDatadogIntegrationAwsEventBridgeRead(d, meta); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,42 @@
+package datadog
+
+import "testing"
+
+func TestAccountRegionAndGeneratorFromID(t *testing.T) {
+	accountID, region, generator, err := accountRegionAndGeneratorFromID("123456789012:us-east-1:app-events")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if accountID != "123456789012" || region != "us-east-1" || generator != "app-events" {
+		t.Errorf("unexpected parts: %q %q %q", accountID, region, generator)
+	}
+
+	if _, _, _, err := accountRegionAndGeneratorFromID("123456789012:us-east-1"); err == nil {
+		t.Error("expected an error for an ID with missing parts")
+	}
+}
+
+func TestMatchEventSourceName(t *testing.T) {
+	// When state already carries the full name, only an exact match counts.
+	if !matchEventSourceName("app-zj5zc", "app", "app-zj5zc") {
+		t.Error("expected an exact match on the stored event source name")
+	}
+	if matchEventSourceName("app-alerts-w4n2x", "app", "app-zj5zc") {
+		t.Error("expected other sources to be rejected when the full name is known")
+	}
+
+	// Without it, names are matched on the suffix boundary, so a generator
+	// name that is a prefix of another one does not adopt its source.
+	if !matchEventSourceName("app-zj5zc", "app", "") {
+		t.Error("expected the generator name plus the assigned suffix to match")
+	}
+	if matchEventSourceName("app-alerts-w4n2x", "app", "") {
+		t.Error("expected a source of a longer generator name to be rejected")
+	}
+	if matchEventSourceName("apple-zj5zc", "app", "") {
+		t.Error("expected a partial name match to be rejected")
+	}
+	if !matchEventSourceName("app-alerts-w4n2x", "app-alerts", "") {
+		t.Error("expected a generator name containing dashes to match its source")
+	}
+}
@@ -14,8 +14,9 @@ func resourceDatadogIntegrationPagerdutySO() *schema.Resource {
 		Read:        resourceDatadogIntegrationPagerdutySORead,
 		Update:      resourceDatadogIntegrationPagerdutySOUpdate,
 		Delete:      resourceDatadogIntegrationPagerdutySODelete,
-		// since the API never returns service_key, it's impossible to meaningfully import resources
-		Importer: nil,
+		Importer: &schema.ResourceImporter{
+			State: resourceDatadogIntegrationPagerdutySOImport,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"service_name": {
@@ -55,6 +56,17 @@ func buildIntegrationPagerdutyServiceKey(d *schema.ResourceData) *datadogV1.Page
 	return serviceKey
 }
 
+// resourceDatadogIntegrationPagerdutySOImport imports a service object by its
+// service name. Since the API never returns service keys, the imported state
+// carries the masked placeholder; the real key from the configuration is
+// pushed on the next apply.
+func resourceDatadogIntegrationPagerdutySOImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if err := resourceDatadogIntegrationPagerdutySORead(d, meta); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceDatadogIntegrationPagerdutySOCreate(d *schema.ResourceData, meta interface{}) error {
 	providerConf := meta.(*ProviderConfiguration)
 	datadogClientV1 := providerConf.DatadogClientV1
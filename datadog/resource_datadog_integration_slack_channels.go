@@ -0,0 +1,186 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// slackChannelsPathFmt templates the per-workspace Slack channel endpoints,
+// which the pinned API client does not cover.
+const slackChannelsPathFmt = "/api/v1/integration/slack/configuration/accounts/%s/channels"
+
+func resourceDatadogIntegrationSlackChannels() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Slack channel resource in bulk form, managing every configured channel of one Slack workspace. Drift is detected with a single list call instead of one call per channel, so large workspaces stay within the API rate limits.",
+		Create:      resourceDatadogIntegrationSlackChannelsCreate,
+		Read:        resourceDatadogIntegrationSlackChannelsRead,
+		Update:      resourceDatadogIntegrationSlackChannelsUpdate,
+		Delete:      resourceDatadogIntegrationSlackChannelsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Description: "The name of the Slack workspace the channels belong to.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"channel": {
+				Description: "The channels configured for the workspace.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the channel, e.g. `#alerts`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"display_message": {
+							Description: "Show the main body of the notification in the channel.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"display_notified": {
+							Description: "Show the list of notified handles in the channel.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"display_snapshot": {
+							Description: "Show the graph snapshot in the channel.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+						"display_tags": {
+							Description: "Show the scope tags in the channel.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildSlackChannelPayload(channel map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{
+		"name": channel["name"].(string),
+		"display": map[string]interface{}{
+			"message":  channel["display_message"].(bool),
+			"notified": channel["display_notified"].(bool),
+			"snapshot": channel["display_snapshot"].(bool),
+			"tags":     channel["display_tags"].(bool),
+		},
+	}
+}
+
+// slackChannelsByName keys the elements of a channel set by channel name, so
+// a changed element of the set (which Terraform reports as one removal plus
+// one addition) can be applied as a single update call.
+func slackChannelsByName(channels *schema.Set) map[string]map[string]interface{} {
+	byName := make(map[string]map[string]interface{})
+	for _, c := range channels.List() {
+		channel := c.(map[string]interface{})
+		byName[channel["name"].(string)] = channel
+	}
+	return byName
+}
+
+func resourceDatadogIntegrationSlackChannelsCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	accountName := d.Get("account_name").(string)
+	channelsPath := fmt.Sprintf(slackChannelsPathFmt, accountName)
+	for _, channel := range slackChannelsByName(d.Get("channel").(*schema.Set)) {
+		if _, err := sendRequest(providerConf, http.MethodPost, channelsPath, buildSlackChannelPayload(channel), nil); err != nil {
+			return translateClientError(err, fmt.Sprintf("error creating slack channel %s", channel["name"]))
+		}
+	}
+	// The workspace has at most one channel configuration, so the workspace
+	// name doubles as the resource ID.
+	d.SetId(accountName)
+
+	return resourceDatadogIntegrationSlackChannelsRead(d, meta)
+}
+
+func resourceDatadogIntegrationSlackChannelsRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response []map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, fmt.Sprintf(slackChannelsPathFmt, d.Id()), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting slack channels")
+	}
+
+	channels := []map[string]interface{}{}
+	for _, channel := range response {
+		display, _ := channel["display"].(map[string]interface{})
+		channels = append(channels, map[string]interface{}{
+			"name":             channel["name"],
+			"display_message":  display["message"],
+			"display_notified": display["notified"],
+			"display_snapshot": display["snapshot"],
+			"display_tags":     display["tags"],
+		})
+	}
+	d.Set("account_name", d.Id())
+	d.Set("channel", channels)
+
+	return nil
+}
+
+func resourceDatadogIntegrationSlackChannelsUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	channelsPath := fmt.Sprintf(slackChannelsPathFmt, d.Id())
+	oldValue, newValue := d.GetChange("channel")
+	oldChannels := slackChannelsByName(oldValue.(*schema.Set))
+	newChannels := slackChannelsByName(newValue.(*schema.Set))
+
+	for name := range oldChannels {
+		if _, kept := newChannels[name]; !kept {
+			if _, err := sendRequest(providerConf, http.MethodDelete, channelsPath+"/"+name, nil, nil); err != nil {
+				return translateClientError(err, fmt.Sprintf("error removing slack channel %s", name))
+			}
+		}
+	}
+	for name, channel := range newChannels {
+		if _, existed := oldChannels[name]; existed {
+			if _, err := sendRequest(providerConf, http.MethodPatch, channelsPath+"/"+name, buildSlackChannelPayload(channel), nil); err != nil {
+				return translateClientError(err, fmt.Sprintf("error updating slack channel %s", name))
+			}
+		} else {
+			if _, err := sendRequest(providerConf, http.MethodPost, channelsPath, buildSlackChannelPayload(channel), nil); err != nil {
+				return translateClientError(err, fmt.Sprintf("error creating slack channel %s", name))
+			}
+		}
+	}
+
+	return resourceDatadogIntegrationSlackChannelsRead(d, meta)
+}
+
+func resourceDatadogIntegrationSlackChannelsDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	channelsPath := fmt.Sprintf(slackChannelsPathFmt, d.Id())
+	for name := range slackChannelsByName(d.Get("channel").(*schema.Set)) {
+		if _, err := sendRequest(providerConf, http.MethodDelete, channelsPath+"/"+name, nil, nil); err != nil {
+			return translateClientError(err, fmt.Sprintf("error removing slack channel %s", name))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,40 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildSlackChannelPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogIntegrationSlackChannels().Schema, map[string]interface{}{
+		"account_name": "main-workspace",
+		"channel": []interface{}{
+			map[string]interface{}{
+				"name":             "#alerts",
+				"display_snapshot": false,
+			},
+		},
+	})
+
+	channels := slackChannelsByName(d.Get("channel").(*schema.Set))
+	channel, ok := channels["#alerts"]
+	if !ok {
+		t.Fatalf("expected the channel to be keyed by name, got %v", channels)
+	}
+
+	payload := buildSlackChannelPayload(channel)
+	expected := map[string]interface{}{
+		"name": "#alerts",
+		"display": map[string]interface{}{
+			"message":  true,
+			"notified": true,
+			"snapshot": false,
+			"tags":     true,
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
@@ -0,0 +1,113 @@
+package datadog
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// logsAccountSettingsPath is the org wide logs settings endpoint, called
+// directly because the pinned API client does not know it.
+const logsAccountSettingsPath = "/api/v2/logs/config/settings"
+
+func resourceDatadogLogsAccountSettings() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog logs account settings resource. The settings are org wide, so only one instance of this resource is needed; it keeps platform defaults consistent across orgs.",
+		Create:      resourceDatadogLogsAccountSettingsCreate,
+		Read:        resourceDatadogLogsAccountSettingsRead,
+		Update:      resourceDatadogLogsAccountSettingsUpdate,
+		Delete:      resourceDatadogLogsAccountSettingsDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"index_daily_quota_default": {
+				Description: "Default daily quota, in events, applied to newly created indexes. 0 leaves new indexes unlimited.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+			"rehydration_concurrency": {
+				Description: "Maximum number of rehydration jobs running concurrently. 0 keeps the account default.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+			},
+		},
+	}
+}
+
+func buildLogsAccountSettingsPayload(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "logs_settings",
+			"attributes": map[string]interface{}{
+				"index_daily_quota_default": d.Get("index_daily_quota_default").(int),
+				"rehydration_concurrency":   d.Get("rehydration_concurrency").(int),
+			},
+		},
+	}
+}
+
+func resourceDatadogLogsAccountSettingsCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodPut, logsAccountSettingsPath, buildLogsAccountSettingsPayload(d), nil); err != nil {
+		return translateClientError(err, "error updating logs account settings")
+	}
+	// The settings are an org wide singleton.
+	d.SetId("logs-account-settings")
+
+	return resourceDatadogLogsAccountSettingsRead(d, meta)
+}
+
+func resourceDatadogLogsAccountSettingsRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, logsAccountSettingsPath, nil, &response); err != nil {
+		return translateClientError(err, "error getting logs account settings")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if quota, ok := attributes["index_daily_quota_default"].(float64); ok {
+		d.Set("index_daily_quota_default", int(quota))
+	}
+	if concurrency, ok := attributes["rehydration_concurrency"].(float64); ok {
+		d.Set("rehydration_concurrency", int(concurrency))
+	}
+
+	return nil
+}
+
+func resourceDatadogLogsAccountSettingsUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodPut, logsAccountSettingsPath, buildLogsAccountSettingsPayload(d), nil); err != nil {
+		return translateClientError(err, "error updating logs account settings")
+	}
+
+	return resourceDatadogLogsAccountSettingsRead(d, meta)
+}
+
+func resourceDatadogLogsAccountSettingsDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	// The API has no delete call for the settings; clearing them restores the
+	// account defaults.
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "logs_settings",
+			"attributes": map[string]interface{}{
+				"index_daily_quota_default": 0,
+				"rehydration_concurrency":   0,
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPut, logsAccountSettingsPath, payload, nil); err != nil {
+		return translateClientError(err, "error resetting logs account settings")
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildLogsAccountSettingsPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogLogsAccountSettings().Schema, map[string]interface{}{
+		"index_daily_quota_default": 1000000,
+	})
+
+	payload := buildLogsAccountSettingsPayload(d)
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "logs_settings",
+			"attributes": map[string]interface{}{
+				"index_daily_quota_default": 1000000,
+				"rehydration_concurrency":   0,
+			},
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
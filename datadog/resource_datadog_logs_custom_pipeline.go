@@ -2,6 +2,7 @@ package datadog
 
 import (
 	"fmt"
+	"net/http"
 	"strings"
 
 	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
@@ -327,10 +328,11 @@ var userAgentParser = &schema.Schema{
 
 func resourceDatadogLogsCustomPipeline() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceDatadogLogsPipelineCreate,
-		Update: resourceDatadogLogsPipelineUpdate,
-		Read:   resourceDatadogLogsPipelineRead,
-		Delete: resourceDatadogLogsPipelineDelete,
+		Create:        resourceDatadogLogsPipelineCreate,
+		Update:        resourceDatadogLogsPipelineUpdate,
+		Read:          resourceDatadogLogsPipelineRead,
+		Delete:        resourceDatadogLogsPipelineDelete,
+		CustomizeDiff: resourceDatadogLogsPipelineCustomizeDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -338,6 +340,108 @@ func resourceDatadogLogsCustomPipeline() *schema.Resource {
 	}
 }
 
+// logsGrokRuleValidationPath is the endpoint used for the opt-in plan time
+// validation of grok parser rules. It is not covered by the pinned API client,
+// so the provider calls it directly.
+const logsGrokRuleValidationPath = "/api/v1/logs/config/processors/validate"
+
+func resourceDatadogLogsPipelineCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.Get("validate_grok_rules").(bool) {
+		return nil
+	}
+	providerConf, ok := meta.(*ProviderConfiguration)
+	if !ok {
+		return nil
+	}
+	processors, _ := diff.Get("processor").([]interface{})
+	return validateGrokProcessors(providerConf, processors)
+}
+
+// validateGrokProcessors sends every grok parser of the pipeline, including
+// parsers nested in pipeline processors, to the validation endpoint.
+func validateGrokProcessors(providerConf *ProviderConfiguration, processors []interface{}) error {
+	for i, p := range processors {
+		processor, _ := p.(map[string]interface{})
+		if nested, ok := processor[tfNestedPipelineProcessor].([]interface{}); ok {
+			for _, n := range nested {
+				if nestedPipeline, ok := n.(map[string]interface{}); ok {
+					if nestedProcessors, ok := nestedPipeline["processor"].([]interface{}); ok {
+						if err := validateGrokProcessors(providerConf, nestedProcessors); err != nil {
+							return err
+						}
+					}
+				}
+			}
+		}
+		grokParsers, _ := processor[tfGrokParserProcessor].([]interface{})
+		for _, g := range grokParsers {
+			grokParser, ok := g.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if err := validateGrokParser(providerConf, i, grokParser); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateGrokParser checks one grok parser against the validation endpoint
+// and reports per-rule parse errors, including which samples failed to match.
+func validateGrokParser(providerConf *ProviderConfiguration, index int, grokParser map[string]interface{}) error {
+	grokRules, _ := grokParser["grok"].([]interface{})
+	if len(grokRules) == 0 {
+		return nil
+	}
+	grok, _ := grokRules[0].(map[string]interface{})
+	samples := []string{}
+	if tfSamples, ok := grokParser["samples"].([]interface{}); ok {
+		for _, s := range tfSamples {
+			if sample, ok := s.(string); ok {
+				samples = append(samples, sample)
+			}
+		}
+	}
+	payload := map[string]interface{}{
+		"processor": map[string]interface{}{
+			"type": "grok-parser",
+			"grok": map[string]interface{}{
+				"support_rules": grok["support_rules"],
+				"match_rules":   grok["match_rules"],
+			},
+			"samples": samples,
+		},
+	}
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, logsGrokRuleValidationPath, payload, &response); err != nil {
+		return translateClientError(err, fmt.Sprintf("error validating grok parser of processor %d", index))
+	}
+	if isValid, ok := response["is_valid"].(bool); ok && isValid {
+		return nil
+	}
+	messages := []string{}
+	if ruleErrors, ok := response["errors"].([]interface{}); ok {
+		for _, e := range ruleErrors {
+			ruleError, _ := e.(map[string]interface{})
+			rule, _ := ruleError["rule"].(string)
+			message, _ := ruleError["message"].(string)
+			messages = append(messages, fmt.Sprintf("rule %q: %s", rule, message))
+		}
+	}
+	if unmatched, ok := response["unmatched_samples"].([]interface{}); ok {
+		for _, s := range unmatched {
+			if sample, ok := s.(string); ok {
+				messages = append(messages, fmt.Sprintf("sample not matched: %q", sample))
+			}
+		}
+	}
+	if len(messages) == 0 {
+		return nil
+	}
+	return fmt.Errorf("grok parser of processor %d failed validation:\n  %s", index, strings.Join(messages, "\n  "))
+}
+
 func resourceDatadogLogsPipelineCreate(d *schema.ResourceData, meta interface{}) error {
 	providerConf := meta.(*ProviderConfiguration)
 	datadogClientV1 := providerConf.DatadogClientV1
@@ -1085,7 +1189,7 @@ func buildDatadogFilter(tfFilter map[string]interface{}) datadogV1.LogsFilter {
 }
 
 func getPipelineSchema(isNested bool) map[string]*schema.Schema {
-	return map[string]*schema.Schema{
+	pipelineSchema := map[string]*schema.Schema{
 		"name":       {Type: schema.TypeString, Required: true},
 		"is_enabled": {Type: schema.TypeBool, Optional: true},
 		"filter": {
@@ -1101,6 +1205,15 @@ func getPipelineSchema(isNested bool) map[string]*schema.Schema {
 			},
 		},
 	}
+	if !isNested {
+		pipelineSchema["validate_grok_rules"] = &schema.Schema{
+			Description: "If set to true, the grok parser rules of the pipeline are checked against the validation endpoint during plan, reporting per-rule parse errors and unmatched samples. Default is false.",
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+		}
+	}
+	return pipelineSchema
 }
 
 func getProcessorSchema(isNested bool) map[string]*schema.Schema {
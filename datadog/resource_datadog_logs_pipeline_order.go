@@ -6,6 +6,7 @@ import (
 
 	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 )
 
 func resourceDatadogLogsPipelineOrder() *schema.Resource {
@@ -30,6 +31,13 @@ func resourceDatadogLogsPipelineOrder() *schema.Resource {
 				Required:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"unmanaged_pipelines": {
+				Description:  "How to handle pipelines that exist in Datadog but are absent from `pipelines`, such as pipelines created by integrations. Either `error` (fail the apply), `append` (order them after the managed pipelines) or `prepend` (order them before the managed pipelines). Defaults to `error`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "error",
+				ValidateFunc: validation.StringInSlice([]string{"error", "append", "prepend"}, false),
+			},
 		},
 	}
 }
@@ -47,7 +55,22 @@ func resourceDatadogLogsPipelineOrderRead(d *schema.ResourceData, meta interface
 		return translateClientError(err, "error getting logs pipeline order")
 	}
 
-	if err = d.Set("pipelines", ddList.PipelineIds); err != nil {
+	pipelineIds := ddList.PipelineIds
+	if strategy := d.Get("unmanaged_pipelines").(string); strategy == "append" || strategy == "prepend" {
+		// Unmanaged pipelines are kept out of the state so they don't
+		// produce a permanent diff against the configured list.
+		managed := make(map[string]bool)
+		for _, id := range d.Get("pipelines").([]interface{}) {
+			managed[id.(string)] = true
+		}
+		pipelineIds = make([]string, 0, len(ddList.PipelineIds))
+		for _, id := range ddList.PipelineIds {
+			if managed[id] {
+				pipelineIds = append(pipelineIds, id)
+			}
+		}
+	}
+	if err = d.Set("pipelines", pipelineIds); err != nil {
 		return err
 	}
 
@@ -69,6 +92,31 @@ func resourceDatadogLogsPipelineOrderUpdate(d *schema.ResourceData, meta interfa
 	providerConf := meta.(*ProviderConfiguration)
 	datadogClientV1 := providerConf.DatadogClientV1
 	authV1 := providerConf.AuthV1
+	if strategy := d.Get("unmanaged_pipelines").(string); strategy == "append" || strategy == "prepend" {
+		// The API requires the full pipeline list, so pipelines absent from
+		// the configuration (e.g. created by integrations) are folded in
+		// according to the configured strategy instead of failing the order
+		// update.
+		ddPipelineOrder, _, err := datadogClientV1.LogsPipelinesApi.GetLogsPipelineOrder(authV1).Execute()
+		if err != nil {
+			return translateClientError(err, "error getting logs pipeline order")
+		}
+		managed := make(map[string]bool, len(ddList))
+		for _, id := range ddList {
+			managed[id] = true
+		}
+		var unmanaged []string
+		for _, id := range ddPipelineOrder.PipelineIds {
+			if !managed[id] {
+				unmanaged = append(unmanaged, id)
+			}
+		}
+		if strategy == "append" {
+			ddPipelineList.PipelineIds = append(ddList, unmanaged...)
+		} else {
+			ddPipelineList.PipelineIds = append(unmanaged, ddList...)
+		}
+	}
 	if _, _, err := datadogClientV1.LogsPipelinesApi.UpdateLogsPipelineOrder(authV1).Body(ddPipelineList).Execute(); err != nil {
 		// Cannot map pipelines to existing ones
 		if strings.Contains(err.Error(), "422 Unprocessable Entity") {
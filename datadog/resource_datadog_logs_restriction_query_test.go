@@ -0,0 +1,40 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildLogsRestrictionQueryPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogLogsRestrictionQuery().Schema, map[string]interface{}{
+		"restriction_query": "env:sandbox",
+	})
+
+	payload := buildLogsRestrictionQueryPayload(d)
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "logs_restriction_queries",
+			"attributes": map[string]interface{}{
+				"restriction_query": "env:sandbox",
+			},
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
+
+func TestBuildLogsRestrictionQueryRolePayload(t *testing.T) {
+	payload := buildLogsRestrictionQueryRolePayload("role-1")
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "roles",
+			"id":   "role-1",
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
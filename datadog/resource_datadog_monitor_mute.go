@@ -0,0 +1,159 @@
+package datadog
+
+import (
+	"strconv"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func resourceDatadogMonitorMute() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog monitor mute resource. This mutes every monitor matching a tag scope in one resource, e.g. for planned maintenance of a whole service, instead of one downtime per monitor. Destroying the resource unmutes the monitors.",
+		Create:      resourceDatadogMonitorMuteCreate,
+		Read:        resourceDatadogMonitorMuteRead,
+		Update:      resourceDatadogMonitorMuteUpdate,
+		Delete:      resourceDatadogMonitorMuteDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"monitor_tags": {
+				Description: "List of monitor tags selecting the monitors to mute; a monitor must have all of them to be muted, e.g. `[\"service:web\"]`.",
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true, // waits for update API call support
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"scope": {
+				Description: "Group scope to mute within the selected monitors, e.g. `host:web-01`. Defaults to `*` (all groups).",
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true, // waits for update API call support
+				Default:     "*",
+			},
+			"end": {
+				Description: "POSIX timestamp to unmute the monitors at. Omit to mute until the resource is destroyed.",
+				Type:        schema.TypeInt,
+				Optional:    true,
+			},
+			"message": {
+				Description: "Message to include with notifications for the mute.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"active": {
+				Description: "Whether the mute is currently active.",
+				Type:        schema.TypeBool,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// buildMonitorMuteDowntime maps the mute to the downtime that implements it:
+// one downtime with monitor tags covers every matching monitor.
+func buildMonitorMuteDowntime(d *schema.ResourceData) *datadogV1.Downtime {
+	dt := datadogV1.NewDowntimeWithDefaults()
+	monitorTags := []string{}
+	for _, t := range d.Get("monitor_tags").([]interface{}) {
+		monitorTags = append(monitorTags, t.(string))
+	}
+	dt.SetMonitorTags(monitorTags)
+	dt.SetScope([]string{d.Get("scope").(string)})
+	if v, ok := d.GetOk("end"); ok {
+		dt.SetEnd(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("message"); ok {
+		dt.SetMessage(v.(string))
+	}
+	return dt
+}
+
+func resourceDatadogMonitorMuteCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	dt, _, err := datadogClientV1.DowntimesApi.CreateDowntime(authV1).Body(*buildMonitorMuteDowntime(d)).Execute()
+	if err != nil {
+		return translateClientError(err, "error muting monitors")
+	}
+	d.SetId(strconv.Itoa(int(dt.GetId())))
+
+	return resourceDatadogMonitorMuteRead(d, meta)
+}
+
+func resourceDatadogMonitorMuteRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return err
+	}
+	dt, httpresp, err := datadogClientV1.DowntimesApi.GetDowntime(authV1, id).Execute()
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting monitor mute")
+	}
+	// A canceled downtime means the monitors were unmuted outside of
+	// Terraform; recreate the mute on next apply.
+	if canceled, ok := dt.GetCanceledOk(); ok && canceled != nil {
+		d.SetId("")
+		return nil
+	}
+
+	monitorTags := dt.GetMonitorTags()
+	if err := d.Set("monitor_tags", monitorTags); err != nil {
+		return err
+	}
+	if scope := dt.GetScope(); len(scope) > 0 {
+		d.Set("scope", scope[0])
+	}
+	d.Set("end", dt.GetEnd())
+	d.Set("message", dt.GetMessage())
+	d.Set("active", dt.GetActive())
+
+	return nil
+}
+
+func resourceDatadogMonitorMuteUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return err
+	}
+	dt := buildMonitorMuteDowntime(d)
+	dt.SetId(id)
+	if _, _, err := datadogClientV1.DowntimesApi.UpdateDowntime(authV1, id).Body(*dt).Execute(); err != nil {
+		return translateClientError(err, "error updating monitor mute")
+	}
+
+	return resourceDatadogMonitorMuteRead(d, meta)
+}
+
+func resourceDatadogMonitorMuteDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+	datadogClientV1 := providerConf.DatadogClientV1
+	authV1 := providerConf.AuthV1
+
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return err
+	}
+	if _, err := datadogClientV1.DowntimesApi.CancelDowntime(authV1, id).Execute(); err != nil {
+		return translateClientError(err, "error unmuting monitors")
+	}
+
+	return nil
+}
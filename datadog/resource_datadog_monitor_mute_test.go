@@ -0,0 +1,30 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildMonitorMuteDowntime(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogMonitorMute().Schema, map[string]interface{}{
+		"monitor_tags": []interface{}{"service:web", "env:prod"},
+		"end":          1735689600,
+		"message":      "planned maintenance",
+	})
+
+	dt := buildMonitorMuteDowntime(d)
+	if !reflect.DeepEqual(dt.GetMonitorTags(), []string{"service:web", "env:prod"}) {
+		t.Errorf("unexpected monitor tags: %v", dt.GetMonitorTags())
+	}
+	if !reflect.DeepEqual(dt.GetScope(), []string{"*"}) {
+		t.Errorf("expected the scope to default to *, got %v", dt.GetScope())
+	}
+	if dt.GetEnd() != 1735689600 {
+		t.Errorf("unexpected end: %v", dt.GetEnd())
+	}
+	if dt.GetMessage() != "planned maintenance" {
+		t.Errorf("unexpected message: %v", dt.GetMessage())
+	}
+}
@@ -0,0 +1,191 @@
+package datadog
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// monitorNotificationRuleRequest mirrors the JSON:API payloads of the
+// /api/v2/monitor/notification_rule endpoints, which are not covered by the
+// generated client yet.
+type monitorNotificationRuleRequest struct {
+	Data monitorNotificationRuleData `json:"data"`
+}
+
+type monitorNotificationRuleData struct {
+	ID         string                            `json:"id,omitempty"`
+	Type       string                            `json:"type"`
+	Attributes monitorNotificationRuleAttributes `json:"attributes"`
+}
+
+type monitorNotificationRuleAttributes struct {
+	Name       string                         `json:"name,omitempty"`
+	Recipients []string                       `json:"recipients,omitempty"`
+	Filter     *monitorNotificationRuleFilter `json:"filter,omitempty"`
+}
+
+type monitorNotificationRuleFilter struct {
+	Tags     []string `json:"tags,omitempty"`
+	Priority *int64   `json:"priority,omitempty"`
+}
+
+const monitorNotificationRuleType = "monitor-notification-rule"
+
+func resourceDatadogMonitorNotificationRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog monitor notification rule resource. This can be used to centrally route monitor alerts to notification handles instead of embedding the handles in every monitor message.",
+		Create:      resourceDatadogMonitorNotificationRuleCreate,
+		Read:        resourceDatadogMonitorNotificationRuleRead,
+		Update:      resourceDatadogMonitorNotificationRuleUpdate,
+		Delete:      resourceDatadogMonitorNotificationRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the monitor notification rule.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"recipients": {
+				Description: "List of recipients to notify, eg. 'slack-ops', 'jira-core'. Do not prefix the handles with '@'.",
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": {
+				Description: "The scope to which the rule applies. Monitors matching the filter have the rule recipients appended to their notifications.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"tags": {
+							Description: "Monitor tags that a monitor must match (all of them) for the rule to apply.",
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"priority": {
+							Description:  "Monitor priority (1 to 5) that a monitor must have for the rule to apply.",
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 5),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildMonitorNotificationRule(d *schema.ResourceData) *monitorNotificationRuleRequest {
+	attributes := monitorNotificationRuleAttributes{
+		Name: d.Get("name").(string),
+	}
+
+	recipients := []string{}
+	for _, recipient := range d.Get("recipients").(*schema.Set).List() {
+		recipients = append(recipients, recipient.(string))
+	}
+	attributes.Recipients = recipients
+
+	if attr, ok := d.GetOk("filter"); ok {
+		filterList := attr.([]interface{})
+		if len(filterList) > 0 {
+			terraformFilter := filterList[0].(map[string]interface{})
+			filter := &monitorNotificationRuleFilter{}
+			tags := []string{}
+			for _, tag := range terraformFilter["tags"].(*schema.Set).List() {
+				tags = append(tags, tag.(string))
+			}
+			filter.Tags = tags
+			if priority, ok := terraformFilter["priority"].(int); ok && priority != 0 {
+				priority64 := int64(priority)
+				filter.Priority = &priority64
+			}
+			attributes.Filter = filter
+		}
+	}
+
+	return &monitorNotificationRuleRequest{
+		Data: monitorNotificationRuleData{
+			Type:       monitorNotificationRuleType,
+			Attributes: attributes,
+		},
+	}
+}
+
+func updateMonitorNotificationRuleState(d *schema.ResourceData, rule *monitorNotificationRuleRequest) error {
+	attributes := rule.Data.Attributes
+	if err := d.Set("name", attributes.Name); err != nil {
+		return err
+	}
+	if err := d.Set("recipients", attributes.Recipients); err != nil {
+		return err
+	}
+	if attributes.Filter != nil {
+		filter := map[string]interface{}{
+			"tags": attributes.Filter.Tags,
+		}
+		if attributes.Filter.Priority != nil {
+			filter["priority"] = *attributes.Filter.Priority
+		}
+		return d.Set("filter", []map[string]interface{}{filter})
+	}
+	return nil
+}
+
+func resourceDatadogMonitorNotificationRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var createdRule monitorNotificationRuleRequest
+	if _, err := sendRequest(providerConf, http.MethodPost, "/api/v2/monitor/notification_rule", buildMonitorNotificationRule(d), &createdRule); err != nil {
+		return translateClientError(err, "error creating monitor notification rule")
+	}
+	d.SetId(createdRule.Data.ID)
+
+	return updateMonitorNotificationRuleState(d, &createdRule)
+}
+
+func resourceDatadogMonitorNotificationRuleRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var rule monitorNotificationRuleRequest
+	httpresp, err := sendRequest(providerConf, http.MethodGet, "/api/v2/monitor/notification_rule/"+d.Id(), nil, &rule)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting monitor notification rule")
+	}
+
+	return updateMonitorNotificationRuleState(d, &rule)
+}
+
+func resourceDatadogMonitorNotificationRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	ruleRequest := buildMonitorNotificationRule(d)
+	ruleRequest.Data.ID = d.Id()
+
+	var updatedRule monitorNotificationRuleRequest
+	if _, err := sendRequest(providerConf, http.MethodPatch, "/api/v2/monitor/notification_rule/"+d.Id(), ruleRequest, &updatedRule); err != nil {
+		return translateClientError(err, "error updating monitor notification rule")
+	}
+
+	return updateMonitorNotificationRuleState(d, &updatedRule)
+}
+
+func resourceDatadogMonitorNotificationRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, "/api/v2/monitor/notification_rule/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting monitor notification rule")
+	}
+
+	return nil
+}
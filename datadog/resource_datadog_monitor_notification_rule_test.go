@@ -0,0 +1,45 @@
+package datadog
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildMonitorNotificationRule(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogMonitorNotificationRule().Schema, map[string]interface{}{
+		"name":       "route to ops",
+		"recipients": []interface{}{"slack-ops", "jira-core"},
+		"filter": []interface{}{
+			map[string]interface{}{
+				"tags":     []interface{}{"team:sre"},
+				"priority": 2,
+			},
+		},
+	})
+
+	rule := buildMonitorNotificationRule(d)
+	if rule.Data.Type != monitorNotificationRuleType {
+		t.Errorf("unexpected payload type %q", rule.Data.Type)
+	}
+	attributes := rule.Data.Attributes
+	if attributes.Name != "route to ops" {
+		t.Errorf("unexpected name %q", attributes.Name)
+	}
+	recipients := append([]string{}, attributes.Recipients...)
+	sort.Strings(recipients)
+	if !reflect.DeepEqual(recipients, []string{"jira-core", "slack-ops"}) {
+		t.Errorf("unexpected recipients: %v", recipients)
+	}
+	if attributes.Filter == nil {
+		t.Fatal("expected a filter to be built")
+	}
+	if !reflect.DeepEqual(attributes.Filter.Tags, []string{"team:sre"}) {
+		t.Errorf("unexpected filter tags: %v", attributes.Filter.Tags)
+	}
+	if attributes.Filter.Priority == nil || *attributes.Filter.Priority != 2 {
+		t.Errorf("unexpected filter priority: %v", attributes.Filter.Priority)
+	}
+}
@@ -0,0 +1,344 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// The reference table endpoints are not covered by the pinned API client, so
+// they are called directly.
+const referenceTablesPath = "/api/v2/reference-tables/tables"
+const referenceTableUploadsPath = "/api/v2/reference-tables/uploads"
+
+func resourceDatadogReferenceTable() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog reference table resource. Reference tables can be used by log enrichment processors to join additional columns onto logs. Rows can be uploaded inline as CSV or synced from a cloud storage bucket.",
+		Create:      resourceDatadogReferenceTableCreate,
+		Read:        resourceDatadogReferenceTableRead,
+		Update:      resourceDatadogReferenceTableUpdate,
+		Delete:      resourceDatadogReferenceTableDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"table_name": {
+				Description: "Name of the reference table, referenced by enrichment processors.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // waits for update API call support
+			},
+			"description": {
+				Description: "Description of the reference table.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"tags": {
+				Description: "List of tags to associate with the reference table.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"table_schema": {
+				Description: "Schema of the reference table.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"field": {
+							Description: "Ordered fields (columns) of the table.",
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Description: "Name of the field.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+									"type": {
+										Description:  "Type of the field, either `STRING` or `INT32`.",
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringInSlice([]string{"STRING", "INT32"}, false),
+									},
+								},
+							},
+						},
+						"primary_keys": {
+							Description: "Names of the fields that form the primary key, used to look up rows.",
+							Type:        schema.TypeList,
+							Required:    true,
+							MinItems:    1,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"inline_csv": {
+				Description:   "CSV content of the table, including the header row. Exactly one of `inline_csv` and `cloud_storage` must be set.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"cloud_storage"},
+			},
+			"cloud_storage": {
+				Description:   "Cloud storage object to sync the table from. Exactly one of `inline_csv` and `cloud_storage` must be set.",
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"inline_csv"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Description:  "Cloud storage provider, one of `s3`, `gcs` or `azure`.",
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringInSlice([]string{"s3", "gcs", "azure"}, false),
+						},
+						"account_id": {
+							Description: "AWS account ID, GCP project ID or Azure storage account name owning the bucket.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"bucket": {
+							Description: "Name of the bucket (or Azure container) holding the CSV file.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"path": {
+							Description: "Path of the CSV file within the bucket.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"sync_enabled": {
+							Description: "Whether the table is refreshed automatically when the file changes.",
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildReferenceTableSchema(d *schema.ResourceData) map[string]interface{} {
+	tableSchema := d.Get("table_schema").([]interface{})[0].(map[string]interface{})
+	fields := []map[string]interface{}{}
+	for _, f := range tableSchema["field"].([]interface{}) {
+		field := f.(map[string]interface{})
+		fields = append(fields, map[string]interface{}{
+			"name": field["name"].(string),
+			"type": field["type"].(string),
+		})
+	}
+	primaryKeys := []string{}
+	for _, k := range tableSchema["primary_keys"].([]interface{}) {
+		primaryKeys = append(primaryKeys, k.(string))
+	}
+	return map[string]interface{}{
+		"fields":       fields,
+		"primary_keys": primaryKeys,
+	}
+}
+
+func buildReferenceTableAccessDetails(cloudStorage map[string]interface{}) map[string]interface{} {
+	detail := map[string]interface{}{"file_path": cloudStorage["path"].(string)}
+	switch cloudStorage["type"].(string) {
+	case "s3":
+		detail["aws_account_id"] = cloudStorage["account_id"].(string)
+		detail["aws_bucket_name"] = cloudStorage["bucket"].(string)
+		return map[string]interface{}{"aws_detail": detail}
+	case "gcs":
+		detail["gcp_project_id"] = cloudStorage["account_id"].(string)
+		detail["gcp_bucket_name"] = cloudStorage["bucket"].(string)
+		return map[string]interface{}{"gcp_detail": detail}
+	default:
+		detail["azure_storage_account_name"] = cloudStorage["account_id"].(string)
+		detail["azure_container_name"] = cloudStorage["bucket"].(string)
+		return map[string]interface{}{"azure_detail": detail}
+	}
+}
+
+// uploadReferenceTableCSV uploads inline CSV content through the reference
+// table upload flow: request a presigned URL from the API, then PUT the CSV
+// there. It returns the upload ID to reference when creating the table.
+func uploadReferenceTableCSV(providerConf *ProviderConfiguration, d *schema.ResourceData, csvContent string) (string, error) {
+	headers := []string{}
+	tableSchema := d.Get("table_schema").([]interface{})[0].(map[string]interface{})
+	for _, f := range tableSchema["field"].([]interface{}) {
+		headers = append(headers, f.(map[string]interface{})["name"].(string))
+	}
+	uploadPayload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "upload",
+			"attributes": map[string]interface{}{
+				"table_name": d.Get("table_name").(string),
+				"headers":    headers,
+				"part_count": 1,
+				"part_size":  len(csvContent),
+			},
+		},
+	}
+	var uploadResponse map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, referenceTableUploadsPath, uploadPayload, &uploadResponse); err != nil {
+		return "", err
+	}
+	data, _ := uploadResponse["data"].(map[string]interface{})
+	uploadID, _ := data["id"].(string)
+	attributes, _ := data["attributes"].(map[string]interface{})
+	partURLs, _ := attributes["part_urls"].([]interface{})
+	if uploadID == "" || len(partURLs) == 0 {
+		return "", fmt.Errorf("upload response is missing the upload ID or part URLs")
+	}
+
+	// The part URL is presigned for a storage host, so it is requested
+	// outside of the API client.
+	putRequest, err := http.NewRequest(http.MethodPut, partURLs[0].(string), strings.NewReader(csvContent))
+	if err != nil {
+		return "", err
+	}
+	putRequest.Header.Set("Content-Type", "text/csv")
+	putResponse, err := http.DefaultClient.Do(putRequest)
+	if err != nil {
+		return "", err
+	}
+	defer putResponse.Body.Close()
+	if putResponse.StatusCode >= 300 {
+		return "", fmt.Errorf("uploading CSV part returned status %s", putResponse.Status)
+	}
+	return uploadID, nil
+}
+
+func buildReferenceTablePayload(providerConf *ProviderConfiguration, d *schema.ResourceData) (map[string]interface{}, error) {
+	attributes := map[string]interface{}{
+		"table_name":  d.Get("table_name").(string),
+		"description": d.Get("description").(string),
+		"schema":      buildReferenceTableSchema(d),
+	}
+	if v, ok := d.GetOk("tags"); ok {
+		tags := []string{}
+		for _, t := range v.([]interface{}) {
+			tags = append(tags, t.(string))
+		}
+		attributes["tags"] = tags
+	}
+	if v, ok := d.GetOk("cloud_storage"); ok {
+		cloudStorage := v.([]interface{})[0].(map[string]interface{})
+		attributes["source"] = strings.ToUpper(cloudStorage["type"].(string))
+		attributes["file_metadata"] = map[string]interface{}{
+			"access_details": buildReferenceTableAccessDetails(cloudStorage),
+			"sync_enabled":   cloudStorage["sync_enabled"].(bool),
+		}
+	} else if v, ok := d.GetOk("inline_csv"); ok {
+		uploadID, err := uploadReferenceTableCSV(providerConf, d, v.(string))
+		if err != nil {
+			return nil, err
+		}
+		attributes["source"] = "LOCAL_FILE"
+		attributes["file_metadata"] = map[string]interface{}{
+			"upload_id": uploadID,
+		}
+	} else {
+		return nil, fmt.Errorf("one of inline_csv or cloud_storage must be set")
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "reference_table",
+			"attributes": attributes,
+		},
+	}, nil
+}
+
+func resourceDatadogReferenceTableCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload, err := buildReferenceTablePayload(providerConf, d)
+	if err != nil {
+		return translateClientError(err, "error creating reference table")
+	}
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, referenceTablesPath, payload, &response); err != nil {
+		return translateClientError(err, "error creating reference table")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, _ := data["id"].(string)
+	d.SetId(id)
+
+	return resourceDatadogReferenceTableRead(d, meta)
+}
+
+func resourceDatadogReferenceTableRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, referenceTablesPath+"/"+d.Id(), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting reference table")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if tableName, ok := attributes["table_name"].(string); ok {
+		d.Set("table_name", tableName)
+	}
+	if description, ok := attributes["description"].(string); ok {
+		d.Set("description", description)
+	}
+	if tags, ok := attributes["tags"].([]interface{}); ok {
+		d.Set("tags", tags)
+	}
+	if tableSchema, ok := attributes["schema"].(map[string]interface{}); ok {
+		fields := []map[string]interface{}{}
+		if responseFields, ok := tableSchema["fields"].([]interface{}); ok {
+			for _, f := range responseFields {
+				field, _ := f.(map[string]interface{})
+				fields = append(fields, map[string]interface{}{
+					"name": field["name"],
+					"type": field["type"],
+				})
+			}
+		}
+		d.Set("table_schema", []map[string]interface{}{{
+			"field":        fields,
+			"primary_keys": tableSchema["primary_keys"],
+		}})
+	}
+	// Neither the inline CSV content nor the upload ID is returned by the
+	// API, so inline_csv is left as configured.
+
+	return nil
+}
+
+func resourceDatadogReferenceTableUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload, err := buildReferenceTablePayload(providerConf, d)
+	if err != nil {
+		return translateClientError(err, "error updating reference table")
+	}
+	if _, err := sendRequest(providerConf, http.MethodPatch, referenceTablesPath+"/"+d.Id(), payload, nil); err != nil {
+		return translateClientError(err, "error updating reference table")
+	}
+
+	return resourceDatadogReferenceTableRead(d, meta)
+}
+
+func resourceDatadogReferenceTableDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, referenceTablesPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting reference table")
+	}
+
+	return nil
+}
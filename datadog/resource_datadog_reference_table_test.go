@@ -0,0 +1,72 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildReferenceTableSchema(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogReferenceTable().Schema, map[string]interface{}{
+		"table_name": "service_owners",
+		"table_schema": []interface{}{
+			map[string]interface{}{
+				"field": []interface{}{
+					map[string]interface{}{"name": "service", "type": "STRING"},
+					map[string]interface{}{"name": "owner", "type": "STRING"},
+				},
+				"primary_keys": []interface{}{"service"},
+			},
+		},
+	})
+
+	tableSchema := buildReferenceTableSchema(d)
+	expected := map[string]interface{}{
+		"fields": []map[string]interface{}{
+			{"name": "service", "type": "STRING"},
+			{"name": "owner", "type": "STRING"},
+		},
+		"primary_keys": []string{"service"},
+	}
+	if !reflect.DeepEqual(tableSchema, expected) {
+		t.Errorf("unexpected schema:\ngot:  %v\nwant: %v", tableSchema, expected)
+	}
+}
+
+func TestBuildReferenceTableAccessDetails(t *testing.T) {
+	cases := []struct {
+		storageType string
+		wantKey     string
+		wantDetail  map[string]interface{}
+	}{
+		{"s3", "aws_detail", map[string]interface{}{
+			"file_path":       "tables/services.csv",
+			"aws_account_id":  "account",
+			"aws_bucket_name": "bucket",
+		}},
+		{"gcs", "gcp_detail", map[string]interface{}{
+			"file_path":       "tables/services.csv",
+			"gcp_project_id":  "account",
+			"gcp_bucket_name": "bucket",
+		}},
+		{"azure", "azure_detail", map[string]interface{}{
+			"file_path":                  "tables/services.csv",
+			"azure_storage_account_name": "account",
+			"azure_container_name":       "bucket",
+		}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.storageType, func(t *testing.T) {
+			details := buildReferenceTableAccessDetails(map[string]interface{}{
+				"type":       tc.storageType,
+				"path":       "tables/services.csv",
+				"account_id": "account",
+				"bucket":     "bucket",
+			})
+			if !reflect.DeepEqual(details, map[string]interface{}{tc.wantKey: tc.wantDetail}) {
+				t.Errorf("unexpected access details: %v", details)
+			}
+		})
+	}
+}
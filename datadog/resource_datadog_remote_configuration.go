@@ -0,0 +1,126 @@
+package datadog
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// remoteConfigurationPath is the org Remote Configuration settings endpoint,
+// missing from the pinned API client.
+const remoteConfigurationPath = "/api/v2/remote_config/organization"
+
+func resourceDatadogRemoteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Remote Configuration settings resource. The settings are org wide, so only one instance of this resource is needed; it lets security teams gate Remote Configuration rollouts.",
+		Create:      resourceDatadogRemoteConfigurationCreate,
+		Read:        resourceDatadogRemoteConfigurationRead,
+		Update:      resourceDatadogRemoteConfigurationUpdate,
+		Delete:      resourceDatadogRemoteConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Description: "Whether Remote Configuration is enabled for the organization.",
+				Type:        schema.TypeBool,
+				Required:    true,
+			},
+			"policy_scope_tags": {
+				Description: "Tags limiting which agents receive policies through Remote Configuration. An empty set scopes policies to all agents.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func updateRemoteConfiguration(d *schema.ResourceData, providerConf *ProviderConfiguration) error {
+	scopeTags := []string{}
+	for _, tag := range d.Get("policy_scope_tags").(*schema.Set).List() {
+		scopeTags = append(scopeTags, tag.(string))
+	}
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "remote_config_organization",
+			"attributes": map[string]interface{}{
+				"enabled":           d.Get("enabled").(bool),
+				"policy_scope_tags": scopeTags,
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPut, remoteConfigurationPath, payload, nil); err != nil {
+		return translateClientError(err, "error updating remote configuration settings")
+	}
+	return nil
+}
+
+func resourceDatadogRemoteConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if err := updateRemoteConfiguration(d, providerConf); err != nil {
+		return err
+	}
+	// The settings are an org wide singleton.
+	d.SetId("remote-configuration")
+
+	return resourceDatadogRemoteConfigurationRead(d, meta)
+}
+
+func resourceDatadogRemoteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, remoteConfigurationPath, nil, &response); err != nil {
+		return translateClientError(err, "error getting remote configuration settings")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if enabled, ok := attributes["enabled"].(bool); ok {
+		d.Set("enabled", enabled)
+	}
+	scopeTags := []string{}
+	if tags, ok := attributes["policy_scope_tags"].([]interface{}); ok {
+		for _, t := range tags {
+			if tag, ok := t.(string); ok {
+				scopeTags = append(scopeTags, tag)
+			}
+		}
+	}
+	d.Set("policy_scope_tags", scopeTags)
+
+	return nil
+}
+
+func resourceDatadogRemoteConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if err := updateRemoteConfiguration(d, providerConf); err != nil {
+		return err
+	}
+
+	return resourceDatadogRemoteConfigurationRead(d, meta)
+}
+
+func resourceDatadogRemoteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	// The API has no delete call for the settings; disabling Remote
+	// Configuration restores the default state.
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "remote_config_organization",
+			"attributes": map[string]interface{}{
+				"enabled":           false,
+				"policy_scope_tags": []string{},
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPut, remoteConfigurationPath, payload, nil); err != nil {
+		return translateClientError(err, "error disabling remote configuration")
+	}
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestUpdateRemoteConfiguration(t *testing.T) {
+	var putBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != remoteConfigurationPath {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		putBody = decodeTestRequestBody(t, r)
+		fmt.Fprint(w, `{}`)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceDatadogRemoteConfiguration().Schema, map[string]interface{}{
+		"enabled":           true,
+		"policy_scope_tags": []interface{}{"env:staging", "env:prod"},
+	})
+	if err := updateRemoteConfiguration(d, testProviderConfForServer(server)); err != nil {
+		t.Fatalf("update returned an error: %s", err)
+	}
+
+	data, _ := putBody["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if data["type"] != "remote_config_organization" || attributes["enabled"] != true {
+		t.Errorf("unexpected update payload: %v", putBody)
+	}
+	scopeTags := []string{}
+	for _, tag := range attributes["policy_scope_tags"].([]interface{}) {
+		scopeTags = append(scopeTags, tag.(string))
+	}
+	sort.Strings(scopeTags)
+	if !reflect.DeepEqual(scopeTags, []string{"env:prod", "env:staging"}) {
+		t.Errorf("unexpected policy scope tags: %v", scopeTags)
+	}
+}
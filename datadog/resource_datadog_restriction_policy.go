@@ -0,0 +1,152 @@
+package datadog
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// restrictionPolicyPath is the restriction policy endpoint, which the pinned
+// API client does not cover.
+const restrictionPolicyPath = "/api/v2/restriction_policy"
+
+func resourceDatadogRestrictionPolicy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog restriction policy resource, binding principals to relations on a single target resource such as a monitor.",
+		Create:      resourceDatadogRestrictionPolicyCreate,
+		Read:        resourceDatadogRestrictionPolicyRead,
+		Update:      resourceDatadogRestrictionPolicyUpdate,
+		Delete:      resourceDatadogRestrictionPolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_id": {
+				Description: "Identifier of the resource the policy applies to, in the form `<type>:<id>`, e.g. `monitor:12345`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"bindings": {
+				Description: "The bindings of the policy.",
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"relation": {
+							Description: "The role/level of access granted by the binding, e.g. `editor` or `viewer`.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"principals": {
+							Description: "A list of principal identifiers, e.g. `role:00000000-0000-1111-0000-000000000000`.",
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func buildRestrictionPolicyPayload(d *schema.ResourceData) map[string]interface{} {
+	bindings := []map[string]interface{}{}
+	for _, b := range d.Get("bindings").([]interface{}) {
+		binding := b.(map[string]interface{})
+		principals := []string{}
+		for _, p := range binding["principals"].(*schema.Set).List() {
+			principals = append(principals, p.(string))
+		}
+		bindings = append(bindings, map[string]interface{}{
+			"relation":   binding["relation"].(string),
+			"principals": principals,
+		})
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "restriction_policy",
+			"id":   d.Get("resource_id").(string),
+			"attributes": map[string]interface{}{
+				"bindings": bindings,
+			},
+		},
+	}
+}
+
+func updateRestrictionPolicy(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	resourceID := d.Get("resource_id").(string)
+	if _, err := sendRequest(providerConf, http.MethodPost, restrictionPolicyPath+"/"+resourceID, buildRestrictionPolicyPayload(d), nil); err != nil {
+		return translateClientError(err, "error updating restriction policy")
+	}
+
+	return nil
+}
+
+func resourceDatadogRestrictionPolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateRestrictionPolicy(d, meta); err != nil {
+		return err
+	}
+	// The policy has no identity of its own: there is at most one per target
+	// resource, so the target identifier doubles as the resource ID.
+	d.SetId(d.Get("resource_id").(string))
+
+	return resourceDatadogRestrictionPolicyRead(d, meta)
+}
+
+func resourceDatadogRestrictionPolicyRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, restrictionPolicyPath+"/"+d.Id(), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting restriction policy")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	d.Set("resource_id", d.Id())
+
+	bindings := []map[string]interface{}{}
+	rawBindings, _ := attributes["bindings"].([]interface{})
+	for _, b := range rawBindings {
+		binding, _ := b.(map[string]interface{})
+		bindings = append(bindings, map[string]interface{}{
+			"relation":   binding["relation"],
+			"principals": binding["principals"],
+		})
+	}
+	// An existing policy with no bindings grants nothing; treat it as absent.
+	if len(bindings) == 0 {
+		d.SetId("")
+		return nil
+	}
+	d.Set("bindings", bindings)
+
+	return nil
+}
+
+func resourceDatadogRestrictionPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateRestrictionPolicy(d, meta); err != nil {
+		return err
+	}
+
+	return resourceDatadogRestrictionPolicyRead(d, meta)
+}
+
+func resourceDatadogRestrictionPolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, restrictionPolicyPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting restriction policy")
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package datadog
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildRestrictionPolicyPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogRestrictionPolicy().Schema, map[string]interface{}{
+		"resource_id": "monitor:12345",
+		"bindings": []interface{}{
+			map[string]interface{}{
+				"relation":   "editor",
+				"principals": []interface{}{"role:role-1", "role:role-2"},
+			},
+		},
+	})
+
+	payload := buildRestrictionPolicyPayload(d)
+	data := payload["data"].(map[string]interface{})
+	if data["type"] != "restriction_policy" || data["id"] != "monitor:12345" {
+		t.Errorf("unexpected payload data: %v", data)
+	}
+	bindings := data["attributes"].(map[string]interface{})["bindings"].([]map[string]interface{})
+	if len(bindings) != 1 || bindings[0]["relation"] != "editor" {
+		t.Fatalf("unexpected bindings: %v", bindings)
+	}
+	principals := append([]string{}, bindings[0]["principals"].([]string)...)
+	sort.Strings(principals)
+	if !reflect.DeepEqual(principals, []string{"role:role-1", "role:role-2"}) {
+		t.Errorf("unexpected principals: %v", principals)
+	}
+}
@@ -0,0 +1,166 @@
+package datadog
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// rumFacetsPath is the RUM facet configuration endpoint, unavailable in the
+// pinned API client.
+const rumFacetsPath = "/api/v2/rum/config/facets"
+
+func resourceDatadogRumFacet() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog RUM facet resource. This can be used to codify which RUM attributes are indexed as facets or measures, including attributes of custom actions (e.g. `@action.target.name`).",
+		Create:      resourceDatadogRumFacetCreate,
+		Read:        resourceDatadogRumFacetRead,
+		Update:      resourceDatadogRumFacetUpdate,
+		Delete:      resourceDatadogRumFacetDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Description: "Path of the RUM attribute to index, e.g. `@action.target.name`.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true, // waits for update API call support
+			},
+			"facet_type": {
+				Description:  "Whether to generate a `facet` (for filtering and grouping) or a `measure` (for numerical aggregation). Defaults to `facet`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true, // waits for update API call support
+				Default:      "facet",
+				ValidateFunc: validation.StringInSlice([]string{"facet", "measure"}, false),
+			},
+			"data_type": {
+				Description:  "Type of the attribute, one of `string`, `double` or `boolean`. Measures must be `double`. Defaults to `string`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "string",
+				ValidateFunc: validation.StringInSlice([]string{"string", "double", "boolean"}, false),
+			},
+			"display_name": {
+				Description: "Human readable name shown in the RUM explorer. Defaults to the last segment of `path`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+			},
+			"group": {
+				Description: "Name of the group the facet is shown under in the RUM explorer facet list.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"unit": {
+				Description: "Unit of a measure, e.g. `millisecond` or `byte`. Only used when `facet_type` is `measure`.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func buildRumFacetPayload(d *schema.ResourceData) map[string]interface{} {
+	attributes := map[string]interface{}{
+		"path": d.Get("path").(string),
+		"type": d.Get("facet_type").(string),
+		"data": map[string]interface{}{
+			"type": d.Get("data_type").(string),
+		},
+	}
+	if v, ok := d.GetOk("display_name"); ok {
+		attributes["display_name"] = v.(string)
+	}
+	if v, ok := d.GetOk("group"); ok {
+		attributes["group"] = v.(string)
+	}
+	if v, ok := d.GetOk("unit"); ok {
+		attributes["unit"] = v.(string)
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "facets",
+			"attributes": attributes,
+		},
+	}
+}
+
+func resourceDatadogRumFacetCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, rumFacetsPath, buildRumFacetPayload(d), &response); err != nil {
+		return translateClientError(err, "error creating RUM facet")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, _ := data["id"].(string)
+	if id == "" {
+		// The API identifies facets by their attribute path.
+		id = d.Get("path").(string)
+	}
+	d.SetId(id)
+
+	return resourceDatadogRumFacetRead(d, meta)
+}
+
+func resourceDatadogRumFacetRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, rumFacetsPath+"/"+d.Id(), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting RUM facet")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	if path, ok := attributes["path"].(string); ok {
+		d.Set("path", path)
+	}
+	if facetType, ok := attributes["type"].(string); ok {
+		d.Set("facet_type", facetType)
+	}
+	if dataAttributes, ok := attributes["data"].(map[string]interface{}); ok {
+		if dataType, ok := dataAttributes["type"].(string); ok {
+			d.Set("data_type", dataType)
+		}
+	}
+	if displayName, ok := attributes["display_name"].(string); ok {
+		d.Set("display_name", displayName)
+	}
+	if group, ok := attributes["group"].(string); ok {
+		d.Set("group", group)
+	}
+	if unit, ok := attributes["unit"].(string); ok {
+		d.Set("unit", unit)
+	}
+
+	return nil
+}
+
+func resourceDatadogRumFacetUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodPatch, rumFacetsPath+"/"+d.Id(), buildRumFacetPayload(d), nil); err != nil {
+		return translateClientError(err, "error updating RUM facet")
+	}
+
+	return resourceDatadogRumFacetRead(d, meta)
+}
+
+func resourceDatadogRumFacetDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, rumFacetsPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting RUM facet")
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package datadog
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildRumFacetPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogRumFacet().Schema, map[string]interface{}{
+		"path":         "@checkout.cart_size",
+		"facet_type":   "measure",
+		"data_type":    "double",
+		"display_name": "Cart size",
+	})
+
+	payload := buildRumFacetPayload(d)
+	data := payload["data"].(map[string]interface{})
+	attributes := data["attributes"].(map[string]interface{})
+	if data["type"] != "facets" {
+		t.Errorf("unexpected payload type %v", data["type"])
+	}
+	if attributes["path"] != "@checkout.cart_size" || attributes["type"] != "measure" {
+		t.Errorf("unexpected attributes: %v", attributes)
+	}
+	if attributes["data"].(map[string]interface{})["type"] != "double" {
+		t.Errorf("unexpected data type: %v", attributes["data"])
+	}
+	if attributes["display_name"] != "Cart size" {
+		t.Errorf("unexpected display name: %v", attributes["display_name"])
+	}
+	if _, ok := attributes["group"]; ok {
+		t.Errorf("expected group to be omitted when unset, got %v", attributes["group"])
+	}
+}
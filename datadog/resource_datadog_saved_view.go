@@ -0,0 +1,132 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// savedViewsPath is the explorer saved views endpoint; it has no bindings in
+// the pinned API client.
+const savedViewsPath = "/api/v2/saved_views"
+
+func resourceDatadogSavedView() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog saved view resource for the logs, RUM and APM explorers, so on-call landing views are consistent across teams.",
+		Create:      resourceDatadogSavedViewCreate,
+		Read:        resourceDatadogSavedViewRead,
+		Update:      resourceDatadogSavedViewUpdate,
+		Delete:      resourceDatadogSavedViewDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the saved view.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"product": {
+				Description:  "The explorer the view belongs to. Valid values are `logs`, `rum`, `apm`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"logs", "rum", "apm"}, false),
+			},
+			"query": {
+				Description: "The search query the view applies.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"columns": {
+				Description: "The columns displayed by the view, in order.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func buildSavedViewPayload(d *schema.ResourceData) map[string]interface{} {
+	columns := []string{}
+	for _, c := range d.Get("columns").([]interface{}) {
+		columns = append(columns, c.(string))
+	}
+	attributes := map[string]interface{}{
+		"name":    d.Get("name").(string),
+		"product": d.Get("product").(string),
+		"query":   d.Get("query").(string),
+		"columns": columns,
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "saved_views",
+			"attributes": attributes,
+		},
+	}
+}
+
+func resourceDatadogSavedViewCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, savedViewsPath, buildSavedViewPayload(d), &response); err != nil {
+		return translateClientError(err, "error creating saved view")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, ok := data["id"].(string)
+	if !ok {
+		return fmt.Errorf("error creating saved view: no id in response")
+	}
+	d.SetId(id)
+
+	return resourceDatadogSavedViewRead(d, meta)
+}
+
+func resourceDatadogSavedViewRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, savedViewsPath+"/"+d.Id(), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting saved view")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	d.Set("name", attributes["name"])
+	d.Set("product", attributes["product"])
+	d.Set("query", attributes["query"])
+	d.Set("columns", attributes["columns"])
+
+	return nil
+}
+
+func resourceDatadogSavedViewUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload := buildSavedViewPayload(d)
+	payload["data"].(map[string]interface{})["id"] = d.Id()
+	if _, err := sendRequest(providerConf, http.MethodPatch, savedViewsPath+"/"+d.Id(), payload, nil); err != nil {
+		return translateClientError(err, "error updating saved view")
+	}
+
+	return resourceDatadogSavedViewRead(d, meta)
+}
+
+func resourceDatadogSavedViewDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, savedViewsPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting saved view")
+	}
+
+	return nil
+}
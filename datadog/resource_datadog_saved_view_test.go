@@ -0,0 +1,33 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildSavedViewPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogSavedView().Schema, map[string]interface{}{
+		"name":    "on-call errors",
+		"product": "logs",
+		"query":   "status:error service:checkout",
+		"columns": []interface{}{"timestamp", "service", "message"},
+	})
+
+	payload := buildSavedViewPayload(d)
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "saved_views",
+			"attributes": map[string]interface{}{
+				"name":    "on-call errors",
+				"product": "logs",
+				"query":   "status:error service:checkout",
+				"columns": []string{"timestamp", "service", "message"},
+			},
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
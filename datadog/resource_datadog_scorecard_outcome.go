@@ -0,0 +1,130 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// scorecardOutcomesPath is the Service Scorecards outcomes endpoint, not
+// modeled by the pinned API client.
+const scorecardOutcomesPath = "/api/v2/scorecard/outcomes"
+
+func resourceDatadogScorecardOutcome() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Service Scorecards outcome resource, reporting the result of a scorecard rule for a service.",
+		Create:      resourceDatadogScorecardOutcomeCreate,
+		Read:        resourceDatadogScorecardOutcomeRead,
+		Update:      resourceDatadogScorecardOutcomeCreate,
+		Delete:      resourceDatadogScorecardOutcomeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rule_id": {
+				Description: "The ID of the scorecard rule the outcome reports on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"service_name": {
+				Description: "The name of the service the outcome reports on.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"state": {
+				Description:  "The result of the rule for the service. Valid values are `pass`, `fail`, `skip`.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringInSlice([]string{"pass", "fail", "skip"}, false),
+			},
+			"remarks": {
+				Description: "Notes shown next to the outcome, e.g. a link to the run that produced it.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func resourceDatadogScorecardOutcomeCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "batched-outcome",
+			"attributes": map[string]interface{}{
+				"results": []map[string]interface{}{{
+					"rule_id":      d.Get("rule_id").(string),
+					"service_name": d.Get("service_name").(string),
+					"state":        d.Get("state").(string),
+					"remarks":      d.Get("remarks").(string),
+				}},
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPost, scorecardOutcomesPath+"/batch", payload, nil); err != nil {
+		return translateClientError(err, "error setting scorecard outcome")
+	}
+	d.SetId(fmt.Sprintf("%s:%s", d.Get("rule_id").(string), d.Get("service_name").(string)))
+
+	return resourceDatadogScorecardOutcomeRead(d, meta)
+}
+
+func resourceDatadogScorecardOutcomeRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	parts := strings.SplitN(d.Id(), ":", 2)
+	if len(parts) != 2 {
+		return fmt.Errorf("invalid scorecard outcome ID %q, expected <rule_id>:<service_name>", d.Id())
+	}
+	ruleID, serviceName := parts[0], parts[1]
+
+	var response map[string]interface{}
+	path := fmt.Sprintf("%s?filter[outcome][rule_id]=%s&filter[outcome][service_name]=%s", scorecardOutcomesPath, ruleID, serviceName)
+	if _, err := sendRequest(providerConf, http.MethodGet, path, nil, &response); err != nil {
+		return translateClientError(err, "error getting scorecard outcome")
+	}
+	outcomes, _ := response["data"].([]interface{})
+	for _, o := range outcomes {
+		outcome, _ := o.(map[string]interface{})
+		attributes, _ := outcome["attributes"].(map[string]interface{})
+		d.Set("rule_id", ruleID)
+		d.Set("service_name", serviceName)
+		d.Set("state", attributes["state"])
+		d.Set("remarks", attributes["remarks"])
+		return nil
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceDatadogScorecardOutcomeDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	// The API has no delete call for outcomes; reporting a skip clears the
+	// result from the scorecard.
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "batched-outcome",
+			"attributes": map[string]interface{}{
+				"results": []map[string]interface{}{{
+					"rule_id":      d.Get("rule_id").(string),
+					"service_name": d.Get("service_name").(string),
+					"state":        "skip",
+				}},
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPost, scorecardOutcomesPath+"/batch", payload, nil); err != nil {
+		return translateClientError(err, "error clearing scorecard outcome")
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestScorecardOutcomeCreate(t *testing.T) {
+	var postBody map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPost {
+			if r.URL.Path != scorecardOutcomesPath+"/batch" {
+				t.Errorf("unexpected path %s", r.URL.Path)
+			}
+			postBody = decodeTestRequestBody(t, r)
+			fmt.Fprint(w, `{}`)
+			return
+		}
+		fmt.Fprint(w, `{"data": [{"attributes": {"state": "pass", "remarks": "see run 42"}}]}`)
+	}))
+	defer server.Close()
+
+	d := schema.TestResourceDataRaw(t, resourceDatadogScorecardOutcome().Schema, map[string]interface{}{
+		"rule_id":      "rule-1",
+		"service_name": "checkout",
+		"state":        "pass",
+		"remarks":      "see run 42",
+	})
+	if err := resourceDatadogScorecardOutcomeCreate(d, testProviderConfForServer(server)); err != nil {
+		t.Fatalf("create returned an error: %s", err)
+	}
+
+	if d.Id() != "rule-1:checkout" {
+		t.Errorf("unexpected ID %q", d.Id())
+	}
+	data, _ := postBody["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	results, _ := attributes["results"].([]interface{})
+	if len(results) != 1 {
+		t.Fatalf("unexpected results: %v", results)
+	}
+	result, _ := results[0].(map[string]interface{})
+	if result["rule_id"] != "rule-1" || result["service_name"] != "checkout" || result["state"] != "pass" {
+		t.Errorf("unexpected outcome payload: %v", result)
+	}
+}
@@ -0,0 +1,131 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// scorecardRulesPath is the Service Scorecards rules endpoint, which the
+// pinned API client does not model.
+const scorecardRulesPath = "/api/v2/scorecard/rules"
+
+func resourceDatadogScorecardRule() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog Service Scorecards custom rule resource, so engineering standards checks are defined next to the services they grade.",
+		Create:      resourceDatadogScorecardRuleCreate,
+		Read:        resourceDatadogScorecardRuleRead,
+		Update:      resourceDatadogScorecardRuleUpdate,
+		Delete:      resourceDatadogScorecardRuleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the rule.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"scorecard_name": {
+				Description: "The name of the scorecard the rule belongs to.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"description": {
+				Description: "The description of the rule, explaining what it checks and how to pass it.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"enabled": {
+				Description: "Whether the rule is evaluated.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+		},
+	}
+}
+
+func buildScorecardRulePayload(d *schema.ResourceData) map[string]interface{} {
+	attributes := map[string]interface{}{
+		"name":           d.Get("name").(string),
+		"scorecard_name": d.Get("scorecard_name").(string),
+		"description":    d.Get("description").(string),
+		"enabled":        d.Get("enabled").(bool),
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "scorecard_rules",
+			"attributes": attributes,
+		},
+	}
+}
+
+func resourceDatadogScorecardRuleCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, scorecardRulesPath, buildScorecardRulePayload(d), &response); err != nil {
+		return translateClientError(err, "error creating scorecard rule")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, ok := data["id"].(string)
+	if !ok {
+		return fmt.Errorf("error creating scorecard rule: no id in response")
+	}
+	d.SetId(id)
+
+	return resourceDatadogScorecardRuleRead(d, meta)
+}
+
+func resourceDatadogScorecardRuleRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	// The list endpoint is the only read endpoint for scorecard rules.
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, scorecardRulesPath+"?filter[rule][id]="+d.Id(), nil, &response); err != nil {
+		return translateClientError(err, "error getting scorecard rule")
+	}
+	rules, _ := response["data"].([]interface{})
+	for _, r := range rules {
+		rule, _ := r.(map[string]interface{})
+		if rule["id"] != d.Id() {
+			continue
+		}
+		attributes, _ := rule["attributes"].(map[string]interface{})
+		d.Set("name", attributes["name"])
+		d.Set("scorecard_name", attributes["scorecard_name"])
+		d.Set("description", attributes["description"])
+		if enabled, ok := attributes["enabled"].(bool); ok {
+			d.Set("enabled", enabled)
+		}
+		return nil
+	}
+	d.SetId("")
+
+	return nil
+}
+
+func resourceDatadogScorecardRuleUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload := buildScorecardRulePayload(d)
+	payload["data"].(map[string]interface{})["id"] = d.Id()
+	if _, err := sendRequest(providerConf, http.MethodPut, scorecardRulesPath+"/"+d.Id(), payload, nil); err != nil {
+		return translateClientError(err, "error updating scorecard rule")
+	}
+
+	return resourceDatadogScorecardRuleRead(d, meta)
+}
+
+func resourceDatadogScorecardRuleDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, scorecardRulesPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting scorecard rule")
+	}
+
+	return nil
+}
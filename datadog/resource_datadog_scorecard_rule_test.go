@@ -0,0 +1,32 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildScorecardRulePayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogScorecardRule().Schema, map[string]interface{}{
+		"name":           "Has a runbook",
+		"scorecard_name": "Production Readiness",
+		"description":    "Every service links a runbook.",
+	})
+
+	payload := buildScorecardRulePayload(d)
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "scorecard_rules",
+			"attributes": map[string]interface{}{
+				"name":           "Has a runbook",
+				"scorecard_name": "Production Readiness",
+				"description":    "Every service links a runbook.",
+				"enabled":        true,
+			},
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
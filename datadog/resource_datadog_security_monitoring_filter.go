@@ -0,0 +1,178 @@
+package datadog
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// securityFiltersPath is the Cloud SIEM security filters endpoint, called
+// directly as the pinned API client lacks it.
+const securityFiltersPath = "/api/v2/security_monitoring/configuration/security_filters"
+
+func resourceDatadogSecurityMonitoringFilter() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog security filter resource, scoping the logs ingested by Cloud SIEM.",
+		Create:      resourceDatadogSecurityMonitoringFilterCreate,
+		Read:        resourceDatadogSecurityMonitoringFilterRead,
+		Update:      resourceDatadogSecurityMonitoringFilterUpdate,
+		Delete:      resourceDatadogSecurityMonitoringFilterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Description: "The name of the security filter.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"query": {
+				Description: "The query of the security filter, selecting the logs it lets through.",
+				Type:        schema.TypeString,
+				Required:    true,
+			},
+			"is_enabled": {
+				Description: "Whether the security filter is applied.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+			},
+			"filtered_data_type": {
+				Description:  "The type of data the security filter applies to. Valid values are `logs`.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "logs",
+				ValidateFunc: validation.StringInSlice([]string{"logs"}, false),
+			},
+			"exclusion_filter": {
+				Description: "Exclusion filters excluding logs from the security filter scope.",
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Description: "The name of the exclusion filter.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+						"query": {
+							Description: "The query of the exclusion filter, selecting the logs it excludes.",
+							Type:        schema.TypeString,
+							Required:    true,
+						},
+					},
+				},
+			},
+			"version": {
+				Description: "The version of the security filter, incremented by the API on each update.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func buildSecurityMonitoringFilterPayload(d *schema.ResourceData) map[string]interface{} {
+	exclusionFilters := []map[string]interface{}{}
+	for _, f := range d.Get("exclusion_filter").([]interface{}) {
+		filter := f.(map[string]interface{})
+		exclusionFilters = append(exclusionFilters, map[string]interface{}{
+			"name":  filter["name"],
+			"query": filter["query"],
+		})
+	}
+	attributes := map[string]interface{}{
+		"name":               d.Get("name").(string),
+		"query":              d.Get("query").(string),
+		"is_enabled":         d.Get("is_enabled").(bool),
+		"filtered_data_type": d.Get("filtered_data_type").(string),
+		"exclusion_filters":  exclusionFilters,
+	}
+	return map[string]interface{}{
+		"data": map[string]interface{}{
+			"type":       "security_filters",
+			"attributes": attributes,
+		},
+	}
+}
+
+func resourceDatadogSecurityMonitoringFilterCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, securityFiltersPath, buildSecurityMonitoringFilterPayload(d), &response); err != nil {
+		return translateClientError(err, "error creating security filter")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	id, ok := data["id"].(string)
+	if !ok {
+		return fmt.Errorf("error creating security filter: no id in response")
+	}
+	d.SetId(id)
+
+	return resourceDatadogSecurityMonitoringFilterRead(d, meta)
+}
+
+func resourceDatadogSecurityMonitoringFilterRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, securityFiltersPath+"/"+d.Id(), nil, &response)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting security filter")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	d.Set("name", attributes["name"])
+	d.Set("query", attributes["query"])
+	if isEnabled, ok := attributes["is_enabled"].(bool); ok {
+		d.Set("is_enabled", isEnabled)
+	}
+	d.Set("filtered_data_type", attributes["filtered_data_type"])
+	exclusionFilters := []map[string]interface{}{}
+	if raw, ok := attributes["exclusion_filters"].([]interface{}); ok {
+		for _, f := range raw {
+			filter, _ := f.(map[string]interface{})
+			exclusionFilters = append(exclusionFilters, map[string]interface{}{
+				"name":  filter["name"],
+				"query": filter["query"],
+			})
+		}
+	}
+	d.Set("exclusion_filter", exclusionFilters)
+	if version, ok := attributes["version"].(float64); ok {
+		d.Set("version", int(version))
+	}
+
+	return nil
+}
+
+func resourceDatadogSecurityMonitoringFilterUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload := buildSecurityMonitoringFilterPayload(d)
+	// The API rejects updates whose version does not match the current one.
+	payload["data"].(map[string]interface{})["attributes"].(map[string]interface{})["version"] = d.Get("version").(int)
+	if _, err := sendRequest(providerConf, http.MethodPatch, securityFiltersPath+"/"+d.Id(), payload, nil); err != nil {
+		return translateClientError(err, "error updating security filter")
+	}
+
+	return resourceDatadogSecurityMonitoringFilterRead(d, meta)
+}
+
+func resourceDatadogSecurityMonitoringFilterDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, securityFiltersPath+"/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting security filter")
+	}
+
+	return nil
+}
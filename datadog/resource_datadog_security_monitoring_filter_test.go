@@ -0,0 +1,43 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildSecurityMonitoringFilterPayload(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogSecurityMonitoringFilter().Schema, map[string]interface{}{
+		"name":  "prod logs",
+		"query": "env:prod",
+		"exclusion_filter": []interface{}{
+			map[string]interface{}{
+				"name":  "healthchecks",
+				"query": "http.url_details.path:/health",
+			},
+		},
+	})
+
+	payload := buildSecurityMonitoringFilterPayload(d)
+	expected := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "security_filters",
+			"attributes": map[string]interface{}{
+				"name":               "prod logs",
+				"query":              "env:prod",
+				"is_enabled":         true,
+				"filtered_data_type": "logs",
+				"exclusion_filters": []map[string]interface{}{
+					{
+						"name":  "healthchecks",
+						"query": "http.url_details.path:/health",
+					},
+				},
+			},
+		},
+	}
+	if !reflect.DeepEqual(payload, expected) {
+		t.Errorf("unexpected payload:\ngot:  %v\nwant: %v", payload, expected)
+	}
+}
@@ -168,6 +168,19 @@ func resourceDatadogServiceLevelObjective() *schema.Resource {
 
 // Use CustomizeDiff to do monitor validation
 func resourceDatadogServiceLevelObjectiveCustomizeDiff(diff *schema.ResourceDiff, meta interface{}) error {
+	if err := validateServiceLevelObjectiveThresholds(diff); err != nil {
+		return err
+	}
+
+	// Thresholds are order-insensitive: don't create a diff when they are
+	// merely reordered, eg. after the API returned them sorted by timeframe.
+	if diff.HasChange("thresholds") {
+		oldValue, newValue := diff.GetChange("thresholds")
+		if thresholdsEquivalent(oldValue.([]interface{}), newValue.([]interface{})) {
+			diff.Clear("thresholds")
+		}
+	}
+
 	if validate, ok := diff.GetOkExists("validate"); ok && !validate.(bool) {
 		// Explicitly skip validation
 		log.Printf("[DEBUG] Validate is %v, skipping validation", validate.(bool))
@@ -195,6 +208,75 @@ func resourceDatadogServiceLevelObjectiveCustomizeDiff(diff *schema.ResourceDiff
 	return nil
 }
 
+// validateServiceLevelObjectiveThresholds checks at plan time that each
+// timeframe is used by at most one threshold and that warning values are
+// greater than their target, which the API only reports on apply.
+func validateServiceLevelObjectiveThresholds(diff *schema.ResourceDiff) error {
+	thresholds, ok := diff.Get("thresholds").([]interface{})
+	if !ok {
+		return nil
+	}
+	seenTimeframes := make(map[string]struct{}, len(thresholds))
+	for _, t := range thresholds {
+		threshold, ok := t.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		timeframe, _ := threshold["timeframe"].(string)
+		if _, seen := seenTimeframes[timeframe]; seen && timeframe != "" {
+			return fmt.Errorf("timeframe %s is used by more than one threshold", timeframe)
+		}
+		seenTimeframes[timeframe] = struct{}{}
+		target, _ := floatOk(threshold["target"])
+		warning, warningOk := floatOk(threshold["warning"])
+		if warningOk && warning != 0 && warning <= target {
+			return fmt.Errorf("warning value (%v) must be greater than target value (%v) for timeframe %s", warning, target, timeframe)
+		}
+	}
+	return nil
+}
+
+// thresholdsEquivalent returns whether two threshold lists only differ by
+// their order.
+func thresholdsEquivalent(oldThresholds, newThresholds []interface{}) bool {
+	if len(oldThresholds) != len(newThresholds) {
+		return false
+	}
+	oldByTimeframe := make(map[string]map[string]interface{}, len(oldThresholds))
+	for _, t := range oldThresholds {
+		if threshold, ok := t.(map[string]interface{}); ok {
+			oldByTimeframe[threshold["timeframe"].(string)] = threshold
+		}
+	}
+	for _, t := range newThresholds {
+		threshold, ok := t.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		oldThreshold, ok := oldByTimeframe[threshold["timeframe"].(string)]
+		if !ok {
+			return false
+		}
+		for _, key := range []string{"target", "warning"} {
+			oldFloat, _ := floatOk(oldThreshold[key])
+			newFloat, _ := floatOk(threshold[key])
+			if oldFloat != newFloat {
+				return false
+			}
+		}
+		for _, key := range []string{"target_display", "warning_display"} {
+			oldDisplay, _ := oldThreshold[key].(string)
+			newDisplay, _ := threshold[key].(string)
+			// displays are API-computed when unset, only compare them when
+			// both sides carry a value
+			if oldDisplay != "" && newDisplay != "" && strings.TrimSpace(oldDisplay) != strings.TrimSpace(newDisplay) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 func buildServiceLevelObjectiveStructs(d *schema.ResourceData) (*datadogV1.ServiceLevelObjective, *datadogV1.ServiceLevelObjectiveRequest) {
 
 	slo := datadogV1.NewServiceLevelObjectiveWithDefaults()
@@ -0,0 +1,198 @@
+package datadog
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// sharedDashboard mirrors the public dashboard payloads of the
+// /api/v1/dashboard/public endpoints, which are not covered by the generated
+// client yet.
+type sharedDashboard struct {
+	DashboardID   *string                    `json:"dashboard_id,omitempty"`
+	DashboardType *string                    `json:"dashboard_type,omitempty"`
+	ShareType     *string                    `json:"share_type,omitempty"`
+	ShareList     []string                   `json:"share_list,omitempty"`
+	Expiration    *string                    `json:"expiration,omitempty"`
+	GlobalTime    *sharedDashboardGlobalTime `json:"global_time,omitempty"`
+	PublicURL     *string                    `json:"public_url,omitempty"`
+	Token         *string                    `json:"token,omitempty"`
+}
+
+type sharedDashboardGlobalTime struct {
+	LiveSpan *string `json:"live_span,omitempty"`
+}
+
+func resourceDatadogSharedDashboard() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog shared dashboard resource. This can be used to share dashboards through a public or invite-only URL.",
+		Create:      resourceDatadogSharedDashboardCreate,
+		Read:        resourceDatadogSharedDashboardRead,
+		Update:      resourceDatadogSharedDashboardUpdate,
+		Delete:      resourceDatadogSharedDashboardDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"dashboard_id": {
+				Description: "The ID of the dashboard to share.",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"dashboard_type": {
+				Description:  "The type of the shared dashboard, either 'custom_timeboard' or 'custom_screenboard'.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "custom_timeboard",
+				ValidateFunc: validation.StringInSlice([]string{"custom_timeboard", "custom_screenboard"}, false),
+			},
+			"share_type": {
+				Description:  "The sharing mode, either 'open' to anybody with the link, or 'invite' to allowlisted email addresses only.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "open",
+				ValidateFunc: validation.StringInSlice([]string{"open", "invite"}, false),
+			},
+			"share_list": {
+				Description: "List of email addresses that can receive an invitation to access the shared dashboard. Only used when share_type is 'invite'.",
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expiration": {
+				Description: "Date of expiration of the share link, in ISO 8601 format. The share is never expired when unset.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"global_time": {
+				Description: "The default timeframe of the shared dashboard, eg. '1h', '4h', '1d'.",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+			"public_url": {
+				Description: "The URL of the shared dashboard.",
+				Type:        schema.TypeString,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func buildSharedDashboard(d *schema.ResourceData) *sharedDashboard {
+	dashboard := &sharedDashboard{}
+
+	dashboardID := d.Get("dashboard_id").(string)
+	dashboard.DashboardID = &dashboardID
+	dashboardType := d.Get("dashboard_type").(string)
+	dashboard.DashboardType = &dashboardType
+	shareType := d.Get("share_type").(string)
+	dashboard.ShareType = &shareType
+
+	if attr, ok := d.GetOk("share_list"); ok {
+		shareList := []string{}
+		for _, email := range attr.(*schema.Set).List() {
+			shareList = append(shareList, email.(string))
+		}
+		dashboard.ShareList = shareList
+	}
+	if attr, ok := d.GetOk("expiration"); ok {
+		expiration := attr.(string)
+		dashboard.Expiration = &expiration
+	}
+	if attr, ok := d.GetOk("global_time"); ok {
+		liveSpan := attr.(string)
+		dashboard.GlobalTime = &sharedDashboardGlobalTime{LiveSpan: &liveSpan}
+	}
+
+	return dashboard
+}
+
+func updateSharedDashboardState(d *schema.ResourceData, dashboard *sharedDashboard) error {
+	if err := d.Set("dashboard_id", dashboard.DashboardID); err != nil {
+		return err
+	}
+	if dashboard.DashboardType != nil {
+		if err := d.Set("dashboard_type", dashboard.DashboardType); err != nil {
+			return err
+		}
+	}
+	if err := d.Set("share_type", dashboard.ShareType); err != nil {
+		return err
+	}
+	if err := d.Set("share_list", dashboard.ShareList); err != nil {
+		return err
+	}
+	if dashboard.Expiration != nil {
+		if err := d.Set("expiration", dashboard.Expiration); err != nil {
+			return err
+		}
+	}
+	if dashboard.GlobalTime != nil && dashboard.GlobalTime.LiveSpan != nil {
+		if err := d.Set("global_time", dashboard.GlobalTime.LiveSpan); err != nil {
+			return err
+		}
+	}
+	return d.Set("public_url", dashboard.PublicURL)
+}
+
+func resourceDatadogSharedDashboardCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var createdDashboard sharedDashboard
+	_, err := sendRequest(providerConf, http.MethodPost, "/api/v1/dashboard/public", buildSharedDashboard(d), &createdDashboard)
+	if err != nil {
+		return translateClientError(err, "error creating shared dashboard")
+	}
+	d.SetId(createdDashboard.GetToken())
+
+	return updateSharedDashboardState(d, &createdDashboard)
+}
+
+func resourceDatadogSharedDashboardRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var dashboard sharedDashboard
+	httpresp, err := sendRequest(providerConf, http.MethodGet, "/api/v1/dashboard/public/"+d.Id(), nil, &dashboard)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting shared dashboard")
+	}
+
+	return updateSharedDashboardState(d, &dashboard)
+}
+
+func resourceDatadogSharedDashboardUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var updatedDashboard sharedDashboard
+	if _, err := sendRequest(providerConf, http.MethodPut, "/api/v1/dashboard/public/"+d.Id(), buildSharedDashboard(d), &updatedDashboard); err != nil {
+		return translateClientError(err, "error updating shared dashboard")
+	}
+
+	return updateSharedDashboardState(d, &updatedDashboard)
+}
+
+func resourceDatadogSharedDashboardDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if _, err := sendRequest(providerConf, http.MethodDelete, "/api/v1/dashboard/public/"+d.Id(), nil, nil); err != nil {
+		return translateClientError(err, "error deleting shared dashboard")
+	}
+
+	return nil
+}
+
+// GetToken returns the share token, or empty string when unset.
+func (s *sharedDashboard) GetToken() string {
+	if s.Token == nil {
+		return ""
+	}
+	return *s.Token
+}
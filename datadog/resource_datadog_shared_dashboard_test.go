@@ -0,0 +1,59 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildSharedDashboard(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogSharedDashboard().Schema, map[string]interface{}{
+		"dashboard_id": "abc-123",
+		"share_type":   "invite",
+		"share_list":   []interface{}{"user@example.com"},
+		"global_time":  "4h",
+	})
+
+	dashboard := buildSharedDashboard(d)
+	if dashboard.DashboardID == nil || *dashboard.DashboardID != "abc-123" {
+		t.Errorf("unexpected dashboard ID: %v", dashboard.DashboardID)
+	}
+	if dashboard.DashboardType == nil || *dashboard.DashboardType != "custom_timeboard" {
+		t.Errorf("expected the dashboard type to default to custom_timeboard, got %v", dashboard.DashboardType)
+	}
+	if dashboard.ShareType == nil || *dashboard.ShareType != "invite" {
+		t.Errorf("unexpected share type: %v", dashboard.ShareType)
+	}
+	if !reflect.DeepEqual(dashboard.ShareList, []string{"user@example.com"}) {
+		t.Errorf("unexpected share list: %v", dashboard.ShareList)
+	}
+	if dashboard.Expiration != nil {
+		t.Errorf("expected no expiration, got %v", *dashboard.Expiration)
+	}
+	if dashboard.GlobalTime == nil || dashboard.GlobalTime.LiveSpan == nil || *dashboard.GlobalTime.LiveSpan != "4h" {
+		t.Errorf("unexpected global time: %v", dashboard.GlobalTime)
+	}
+}
+
+func TestUpdateSharedDashboardState(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogSharedDashboard().Schema, map[string]interface{}{})
+
+	dashboardID := "abc-123"
+	shareType := "open"
+	publicURL := "https://p.datadoghq.com/sb/token"
+	if err := updateSharedDashboardState(d, &sharedDashboard{
+		DashboardID: &dashboardID,
+		ShareType:   &shareType,
+		PublicURL:   &publicURL,
+	}); err != nil {
+		t.Fatalf("error updating state: %s", err)
+	}
+
+	if d.Get("dashboard_id").(string) != "abc-123" || d.Get("share_type").(string) != "open" {
+		t.Errorf("unexpected state: %v %v", d.Get("dashboard_id"), d.Get("share_type"))
+	}
+	if d.Get("public_url").(string) != publicURL {
+		t.Errorf("unexpected public URL: %v", d.Get("public_url"))
+	}
+}
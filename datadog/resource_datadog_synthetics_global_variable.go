@@ -1,6 +1,10 @@
 package datadog
 
 import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
 	"regexp"
 
 	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
@@ -37,10 +41,19 @@ func resourceDatadogSyntheticsGlobalVariable() *schema.Resource {
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
 			"value": {
-				Description: "The value of the global variable.",
-				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
+				Description:  "The value of the global variable.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ExactlyOneOf: []string{"value", "value_wo"},
+			},
+			"value_wo": {
+				Description:  "The value of the global variable, supplied write-only: only a SHA-256 digest of the value is persisted in state. Use this instead of `value` when the raw secret must not be stored in state.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				StateFunc:    hashSensitiveValue,
+				ExactlyOneOf: []string{"value", "value_wo"},
 			},
 			"secure": {
 				Description: "Sets the variable as secure. Defaults to `false`.",
@@ -52,6 +65,12 @@ func resourceDatadogSyntheticsGlobalVariable() *schema.Resource {
 	}
 }
 
+// hashSensitiveValue is the StateFunc for write-only values: only the SHA-256
+// digest of the value, never the raw secret, is persisted in state.
+func hashSensitiveValue(v interface{}) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(v.(string))))
+}
+
 func resourceDatadogSyntheticsGlobalVariableCreate(d *schema.ResourceData, meta interface{}) error {
 	providerConf := meta.(*ProviderConfiguration)
 	datadogClientV1 := providerConf.DatadogClientV1
@@ -97,6 +116,19 @@ func resourceDatadogSyntheticsGlobalVariableUpdate(d *schema.ResourceData, meta
 	authV1 := providerConf.AuthV1
 
 	syntheticsGlobalVariable := buildSyntheticsGlobalVariableStruct(d)
+	if _, ok := d.GetOk("value_wo"); ok && !d.HasChange("value_wo") {
+		// The raw write-only value cannot be recovered from state, so edit
+		// the variable without its value field and let the API keep the
+		// stored secret.
+		payload, err := syntheticsGlobalVariableEditPayloadWithoutValue(syntheticsGlobalVariable)
+		if err != nil {
+			return err
+		}
+		if _, err := sendRequest(providerConf, http.MethodPut, "/api/v1/synthetics/variables/"+d.Id(), payload, nil); err != nil {
+			return translateClientError(err, "error updating synthetics global variable")
+		}
+		return resourceDatadogSyntheticsGlobalVariableRead(d, meta)
+	}
 	if _, _, err := datadogClientV1.SyntheticsApi.EditGlobalVariable(authV1, d.Id()).Body(*syntheticsGlobalVariable).Execute(); err != nil {
 		// If the Update callback returns with or without an error, the full state is saved.
 		translateClientError(err, "error updating synthetics global variable")
@@ -139,7 +171,17 @@ func buildSyntheticsGlobalVariableStruct(d *schema.ResourceData) *datadogV1.Synt
 
 	syntheticsGlobalVariableValue := datadogV1.SyntheticsGlobalVariableValue{}
 
-	syntheticsGlobalVariableValue.SetValue(d.Get("value").(string))
+	value := d.Get("value").(string)
+	if wo, ok := d.GetOk("value_wo"); ok {
+		// The SDK only surfaces the raw pre-StateFunc value while the
+		// attribute has a diff entry; on any other read d.Get returns the
+		// digest stored in state, which must never be sent as the value.
+		value = ""
+		if d.HasChange("value_wo") {
+			value = wo.(string)
+		}
+	}
+	syntheticsGlobalVariableValue.SetValue(value)
 	syntheticsGlobalVariableValue.SetSecure(d.Get("secure").(bool))
 
 	syntheticsGlobalVariable.SetValue(syntheticsGlobalVariableValue)
@@ -147,18 +189,36 @@ func buildSyntheticsGlobalVariableStruct(d *schema.ResourceData) *datadogV1.Synt
 	return syntheticsGlobalVariable
 }
 
+// syntheticsGlobalVariableEditPayloadWithoutValue serializes the variable and
+// drops its value field, so an edit does not overwrite the secret stored by
+// the API.
+func syntheticsGlobalVariableEditPayloadWithoutValue(syntheticsGlobalVariable *datadogV1.SyntheticsGlobalVariable) (map[string]interface{}, error) {
+	marshaled, err := json.Marshal(syntheticsGlobalVariable)
+	if err != nil {
+		return nil, err
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(marshaled, &payload); err != nil {
+		return nil, err
+	}
+	delete(payload, "value")
+	return payload, nil
+}
+
 func updateSyntheticsGlobalVariableLocalState(d *schema.ResourceData, syntheticsGlobalVariable *datadogV1.SyntheticsGlobalVariable) error {
 	d.Set("name", syntheticsGlobalVariable.GetName())
 	d.Set("description", syntheticsGlobalVariable.GetDescription())
 
 	syntheticsGlobalVariableValue := syntheticsGlobalVariable.GetValue()
 
-	if syntheticsGlobalVariableValue.GetSecure() {
-		// if the global variable is secure we need to get the value
-		// from the config since it will not be returned by the api
-		d.Set("value", d.Get("value").(string))
-	} else {
-		d.Set("value", syntheticsGlobalVariableValue.GetValue())
+	if _, ok := d.GetOk("value_wo"); !ok {
+		if syntheticsGlobalVariableValue.GetSecure() {
+			// if the global variable is secure we need to get the value
+			// from the config since it will not be returned by the api
+			d.Set("value", d.Get("value").(string))
+		} else {
+			d.Set("value", syntheticsGlobalVariableValue.GetValue())
+		}
 	}
 
 	d.Set("secure", syntheticsGlobalVariableValue.GetSecure())
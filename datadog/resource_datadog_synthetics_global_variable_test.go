@@ -272,3 +272,74 @@ func testSyntheticsGlobalVariableIsDestroyed(accProvider *schema.Provider) resou
 		return nil
 	}
 }
+
+func testSyntheticsGlobalVariableData(t *testing.T, state *terraform.InstanceState, diff *terraform.InstanceDiff) *schema.ResourceData {
+	d, err := schema.InternalMap(resourceDatadogSyntheticsGlobalVariable().Schema).Data(state, diff)
+	if err != nil {
+		t.Fatalf("error building resource data: %s", err)
+	}
+	return d
+}
+
+func TestSyntheticsGlobalVariableValueWoUnchanged(t *testing.T) {
+	digest := hashSensitiveValue("raw-secret")
+	state := &terraform.InstanceState{
+		ID: "variable-id",
+		Attributes: map[string]string{
+			"name":     "MY_VARIABLE",
+			"value_wo": digest,
+			"secure":   "false",
+			"tags.#":   "1",
+			"tags.0":   "foo:bar",
+		},
+	}
+	// A tags-only update: value_wo has no diff entry, so d.Get returns the
+	// digest from state and the value must not be sent.
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"tags.0": {Old: "foo:bar", New: "foo:baz"},
+		},
+	}
+	d := testSyntheticsGlobalVariableData(t, state, diff)
+
+	syntheticsGlobalVariable := buildSyntheticsGlobalVariableStruct(d)
+	if value := syntheticsGlobalVariable.GetValue(); value.GetValue() != "" {
+		t.Errorf("expected no value for an unchanged value_wo, got %q", value.GetValue())
+	}
+
+	payload, err := syntheticsGlobalVariableEditPayloadWithoutValue(syntheticsGlobalVariable)
+	if err != nil {
+		t.Fatalf("error building edit payload: %s", err)
+	}
+	if _, ok := payload["value"]; ok {
+		t.Errorf("expected no value field in the edit payload, got %v", payload["value"])
+	}
+	if payload["name"] != "MY_VARIABLE" {
+		t.Errorf("expected name in the edit payload, got %v", payload["name"])
+	}
+}
+
+func TestSyntheticsGlobalVariableValueWoChanged(t *testing.T) {
+	oldDigest := hashSensitiveValue("old-secret")
+	state := &terraform.InstanceState{
+		ID: "variable-id",
+		Attributes: map[string]string{
+			"name":     "MY_VARIABLE",
+			"value_wo": oldDigest,
+			"secure":   "false",
+		},
+	}
+	// When the value changes, the SDK carries the raw pre-StateFunc value in
+	// the diff and it must be sent as-is.
+	diff := &terraform.InstanceDiff{
+		Attributes: map[string]*terraform.ResourceAttrDiff{
+			"value_wo": {Old: oldDigest, New: hashSensitiveValue("new-secret"), NewExtra: "new-secret"},
+		},
+	}
+	d := testSyntheticsGlobalVariableData(t, state, diff)
+
+	syntheticsGlobalVariable := buildSyntheticsGlobalVariableStruct(d)
+	if value := syntheticsGlobalVariable.GetValue(); value.GetValue() != "new-secret" {
+		t.Errorf("expected the raw changed value to be sent, got %q", value.GetValue())
+	}
+}
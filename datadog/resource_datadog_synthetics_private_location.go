@@ -34,8 +34,14 @@ func resourceDatadogSyntheticsPrivateLocation() *schema.Resource {
 				Optional:    true,
 				Elem:        &schema.Schema{Type: schema.TypeString},
 			},
+			"skip_config_in_state": {
+				Description: "Do not store the configuration skeleton in state. The skeleton is only returned when the private location is created, so it must then be retrieved from the creation logs or the Datadog application instead of the `config` attribute.",
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+			},
 			"config": {
-				Description: "Configuration skeleton for the private location. See installation instructions of the private location on how to use this configuration.",
+				Description: "Configuration skeleton for the private location. See installation instructions of the private location on how to use this configuration. Empty when `skip_config_in_state` is set.",
 				Type:        schema.TypeString,
 				Computed:    true,
 				Sensitive:   true,
@@ -62,8 +68,12 @@ func resourceDatadogSyntheticsPrivateLocationCreate(d *schema.ResourceData, meta
 	d.SetId(createdSyntheticsPrivateLocation.GetId())
 
 	// set the config that is only returned when creating the private location
-	conf, _ := json.Marshal(createdSyntheticsPrivateLocationResponse.GetConfig())
-	d.Set("config", string(conf))
+	if d.Get("skip_config_in_state").(bool) {
+		d.Set("config", "")
+	} else {
+		conf, _ := json.Marshal(createdSyntheticsPrivateLocationResponse.GetConfig())
+		d.Set("config", string(conf))
+	}
 
 	// Return the read function to ensure the state is reflected in the terraform.state file
 	return resourceDatadogSyntheticsPrivateLocationRead(d, meta)
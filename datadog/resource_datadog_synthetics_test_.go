@@ -9,6 +9,7 @@ import (
 	_nethttp "net/http"
 	"regexp"
 	"strconv"
+	"strings"
 
 	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -17,6 +18,11 @@ import (
 
 var syntheticsConfigVariableTypes = []string{"text"}
 
+// syntheticsAssertionOperatorValidatesXPath is the operator used by XPath
+// assertions. The pinned API client does not model it yet, so the provider
+// carries the value itself.
+const syntheticsAssertionOperatorValidatesXPath = "validatesXPath"
+
 func resourceDatadogSyntheticsTest() *schema.Resource {
 	return &schema.Resource{
 		Description: "Provides a Datadog synthetics test resource. This can be used to create and manage Datadog synthetics test.",
@@ -27,6 +33,7 @@ func resourceDatadogSyntheticsTest() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: validateSyntheticsAssertions,
 		Schema: map[string]*schema.Schema{
 			"type": {
 				Description:  "Synthetics test type (`api` or `browser`).",
@@ -155,6 +162,31 @@ func resourceDatadogSyntheticsTest() *schema.Resource {
 								},
 							},
 						},
+						"targetxpath": {
+							Description: "Expected structure if `operator` is `validatesXPath`. Exactly one nested block is allowed with the structure below.",
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"operator": {
+										Description: "The specific operator to use on the path.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+									"xpath": {
+										Description: "The xpath to assert.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+									"targetvalue": {
+										Description: "Expected matching value.",
+										Type:        schema.TypeString,
+										Required:    true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -459,6 +491,68 @@ func syntheticsTestOptionsList() *schema.Schema {
 						},
 					},
 				},
+				"ci": {
+					Description: "CI/CD options for the test.",
+					Type:        schema.TypeList,
+					MaxItems:    1,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"execution_rule": {
+								Description:  "Execution rule for the test in CI/CD pipelines. Valid values are `blocking`, `non_blocking`, `skipped`.",
+								Type:         schema.TypeString,
+								Optional:     true,
+								ValidateFunc: validation.StringInSlice([]string{"blocking", "non_blocking", "skipped"}, false),
+							},
+						},
+					},
+				},
+				"restricted_roles": {
+					Description: "A list of role identifiers allowed to edit the test.",
+					Type:        schema.TypeSet,
+					Optional:    true,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+				},
+				"scheduling": {
+					Description: "Scheduling windows during which the test runs.",
+					Type:        schema.TypeList,
+					MaxItems:    1,
+					Optional:    true,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"timeframes": {
+								Description: "Timeframes describing the scheduling pattern to apply to each day.",
+								Type:        schema.TypeSet,
+								Required:    true,
+								Elem: &schema.Resource{
+									Schema: map[string]*schema.Schema{
+										"day": {
+											Description:  "Number representing the day of the week, from `1` (Monday) to `7` (Sunday).",
+											Type:         schema.TypeInt,
+											Required:     true,
+											ValidateFunc: validation.IntBetween(1, 7),
+										},
+										"from": {
+											Description: "The hour of the day at which scheduling starts, e.g. `07:00`.",
+											Type:        schema.TypeString,
+											Required:    true,
+										},
+										"to": {
+											Description: "The hour of the day at which scheduling ends, e.g. `16:00`.",
+											Type:        schema.TypeString,
+											Required:    true,
+										},
+									},
+								},
+							},
+							"timezone": {
+								Description: "Timezone in which the timeframes are based, e.g. `America/New_York`.",
+								Type:        schema.TypeString,
+								Required:    true,
+							},
+						},
+					},
+				},
 			},
 		},
 	}
@@ -591,6 +685,141 @@ func syntheticsConfigVariable() *schema.Schema {
 	}
 }
 
+// syntheticsExtendedOptions collects the options_list fields the pinned API
+// client does not model yet. Removed fields are included as explicit nulls so
+// the API clears them.
+func syntheticsExtendedOptions(d *schema.ResourceData) map[string]interface{} {
+	options := map[string]interface{}{}
+	if _, ok := d.GetOk("options_list.0.ci"); ok {
+		options["ci"] = map[string]interface{}{
+			"executionRule": d.Get("options_list.0.ci.0.execution_rule"),
+		}
+	} else if d.HasChange("options_list.0.ci") {
+		options["ci"] = nil
+	}
+	if attr, ok := d.GetOk("options_list.0.restricted_roles"); ok {
+		roles := []string{}
+		for _, role := range attr.(*schema.Set).List() {
+			roles = append(roles, role.(string))
+		}
+		options["restricted_roles"] = roles
+	} else if d.HasChange("options_list.0.restricted_roles") {
+		options["restricted_roles"] = nil
+	}
+	if _, ok := d.GetOk("options_list.0.scheduling"); ok {
+		timeframes := []map[string]interface{}{}
+		for _, tf := range d.Get("options_list.0.scheduling.0.timeframes").(*schema.Set).List() {
+			timeframe := tf.(map[string]interface{})
+			timeframes = append(timeframes, map[string]interface{}{
+				"day":  timeframe["day"],
+				"from": timeframe["from"],
+				"to":   timeframe["to"],
+			})
+		}
+		options["scheduling"] = map[string]interface{}{
+			"timeframes": timeframes,
+			"timezone":   d.Get("options_list.0.scheduling.0.timezone"),
+		}
+	} else if d.HasChange("options_list.0.scheduling") {
+		options["scheduling"] = nil
+	}
+	return options
+}
+
+// updateSyntheticsExtendedOptions pushes the extended options through a direct
+// edit call. The full test payload is re-sent alongside them so the edit never
+// clobbers fields the pinned client does model.
+func updateSyntheticsExtendedOptions(d *schema.ResourceData, providerConf *ProviderConfiguration) error {
+	extended := syntheticsExtendedOptions(d)
+	if len(extended) == 0 {
+		return nil
+	}
+	syntheticsTest := buildSyntheticsTestStruct(d)
+	marshaled, err := json.Marshal(syntheticsTest)
+	if err != nil {
+		return err
+	}
+	var payload map[string]interface{}
+	if err := json.Unmarshal(marshaled, &payload); err != nil {
+		return err
+	}
+	options, _ := payload["options"].(map[string]interface{})
+	if options == nil {
+		options = map[string]interface{}{}
+	}
+	for k, v := range extended {
+		options[k] = v
+	}
+	payload["options"] = options
+	if _, err := sendRequest(providerConf, _nethttp.MethodPut, "/api/v1/synthetics/tests/"+d.Id(), payload, nil); err != nil {
+		return translateClientError(err, "error updating synthetics test options")
+	}
+	return nil
+}
+
+// readSyntheticsExtendedOptions loads the extended options the pinned API
+// client drops from the test response. The extra call is only made when the
+// resource uses one of them.
+func readSyntheticsExtendedOptions(d *schema.ResourceData, providerConf *ProviderConfiguration) error {
+	_, hasCi := d.GetOk("options_list.0.ci")
+	_, hasRoles := d.GetOk("options_list.0.restricted_roles")
+	_, hasScheduling := d.GetOk("options_list.0.scheduling")
+	if !hasCi && !hasRoles && !hasScheduling {
+		return nil
+	}
+	path := "/api/v1/synthetics/tests/" + d.Id()
+	if d.Get("type") == "browser" {
+		path = "/api/v1/synthetics/tests/browser/" + d.Id()
+	}
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, _nethttp.MethodGet, path, nil, &response); err != nil {
+		return translateClientError(err, "error getting synthetics test options")
+	}
+	options, _ := response["options"].(map[string]interface{})
+
+	optionsLists, ok := d.Get("options_list").([]interface{})
+	if !ok || len(optionsLists) == 0 {
+		return nil
+	}
+	localOptionsList, _ := optionsLists[0].(map[string]interface{})
+	localCi := []map[string]interface{}{}
+	if ci, ok := options["ci"].(map[string]interface{}); ok {
+		localCi = append(localCi, map[string]interface{}{"execution_rule": ci["executionRule"]})
+	}
+	localOptionsList["ci"] = localCi
+	restrictedRoles := []string{}
+	if roles, ok := options["restricted_roles"].([]interface{}); ok {
+		for _, r := range roles {
+			if role, ok := r.(string); ok {
+				restrictedRoles = append(restrictedRoles, role)
+			}
+		}
+	}
+	localOptionsList["restricted_roles"] = restrictedRoles
+	localScheduling := []map[string]interface{}{}
+	if scheduling, ok := options["scheduling"].(map[string]interface{}); ok {
+		localTimeframes := []map[string]interface{}{}
+		if timeframes, ok := scheduling["timeframes"].([]interface{}); ok {
+			for _, tf := range timeframes {
+				timeframe, _ := tf.(map[string]interface{})
+				day, _ := timeframe["day"].(float64)
+				localTimeframes = append(localTimeframes, map[string]interface{}{
+					"day":  int(day),
+					"from": timeframe["from"],
+					"to":   timeframe["to"],
+				})
+			}
+		}
+		localScheduling = append(localScheduling, map[string]interface{}{
+			"timeframes": localTimeframes,
+			"timezone":   scheduling["timezone"],
+		})
+	}
+	localOptionsList["scheduling"] = localScheduling
+
+	return d.Set("options_list", optionsLists)
+}
+
 func resourceDatadogSyntheticsTestCreate(d *schema.ResourceData, meta interface{}) error {
 	providerConf := meta.(*ProviderConfiguration)
 	datadogClientV1 := providerConf.DatadogClientV1
@@ -607,6 +836,10 @@ func resourceDatadogSyntheticsTestCreate(d *schema.ResourceData, meta interface{
 	// the resource is assumed to not be created, and no state is saved.
 	d.SetId(createdSyntheticsTest.GetPublicId())
 
+	if err := updateSyntheticsExtendedOptions(d, providerConf); err != nil {
+		return err
+	}
+
 	// Return the read function to ensure the state is reflected in the terraform.state file
 	return resourceDatadogSyntheticsTestRead(d, meta)
 }
@@ -640,7 +873,11 @@ func resourceDatadogSyntheticsTestRead(d *schema.ResourceData, meta interface{})
 		return translateClientError(err, "error getting synthetics test")
 	}
 
-	return updateSyntheticsTestLocalState(d, &syntheticsTest)
+	if err := updateSyntheticsTestLocalState(d, &syntheticsTest); err != nil {
+		return err
+	}
+
+	return readSyntheticsExtendedOptions(d, providerConf)
 }
 
 func resourceDatadogSyntheticsTestUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -654,6 +891,10 @@ func resourceDatadogSyntheticsTestUpdate(d *schema.ResourceData, meta interface{
 		return translateClientError(err, "error updating synthetics test")
 	}
 
+	if err := updateSyntheticsExtendedOptions(d, providerConf); err != nil {
+		return err
+	}
+
 	// Return the read function to ensure the state is reflected in the terraform.state file
 	return resourceDatadogSyntheticsTestRead(d, meta)
 }
@@ -827,6 +1068,39 @@ func buildSyntheticsTestStruct(d *schema.ResourceData) *datadogV1.SyntheticsTest
 							log.Printf("[WARN] target shouldn't be specified for validateJSONPath operator, only targetjsonpath")
 						}
 						config.Assertions = append(config.Assertions, datadogV1.SyntheticsAssertionJSONPathTargetAsSyntheticsAssertion(assertionJSONPathTarget))
+					} else if assertionOperator == syntheticsAssertionOperatorValidatesXPath {
+						// The pinned API client has no dedicated model for XPath
+						// targets, so the target is sent as a raw object through
+						// the generic assertion target.
+						assertionXPathTarget := datadogV1.NewSyntheticsAssertionTarget(datadogV1.SyntheticsAssertionOperator(assertionOperator), datadogV1.SyntheticsAssertionType(assertionType))
+						if v, ok := assertionMap["property"].(string); ok && len(v) > 0 {
+							assertionXPathTarget.SetProperty(v)
+						}
+						if v, ok := assertionMap["targetxpath"].([]interface{}); ok && len(v) > 0 {
+							subTarget := make(map[string]interface{})
+							targetMap := v[0].(map[string]interface{})
+							if v, ok := targetMap["xpath"]; ok {
+								subTarget["xPath"] = v.(string)
+							}
+							operator, ok := targetMap["operator"]
+							if ok {
+								subTarget["operator"] = operator.(string)
+							}
+							if v, ok := targetMap["targetvalue"]; ok {
+								switch datadogV1.SyntheticsAssertionOperator(operator.(string)) {
+								case
+									datadogV1.SYNTHETICSASSERTIONOPERATOR_LESS_THAN,
+									datadogV1.SYNTHETICSASSERTIONOPERATOR_MORE_THAN:
+									if floatValue, err := strconv.ParseFloat(v.(string), 64); err == nil {
+										subTarget["targetValue"] = floatValue
+									}
+								default:
+									subTarget["targetValue"] = v
+								}
+							}
+							assertionXPathTarget.SetTarget(subTarget)
+						}
+						config.Assertions = append(config.Assertions, datadogV1.SyntheticsAssertionTargetAsSyntheticsAssertion(assertionXPathTarget))
 					} else {
 						assertionTarget := datadogV1.NewSyntheticsAssertionTarget(datadogV1.SyntheticsAssertionOperator(assertionOperator), datadogV1.SyntheticsAssertionType(assertionType))
 						if v, ok := assertionMap["property"].(string); ok && len(v) > 0 {
@@ -1134,7 +1408,23 @@ func updateSyntheticsTestLocalState(d *schema.ResourceData, syntheticsTest *data
 				localAssertion["property"] = assertionTarget.GetProperty()
 			}
 			if target := assertionTarget.GetTarget(); target != nil {
-				localAssertion["target"] = convertToString(target)
+				if targetMap, ok := target.(map[string]interface{}); ok && assertionTarget.GetOperator() == syntheticsAssertionOperatorValidatesXPath {
+					// XPath targets come back as raw objects since the pinned
+					// client has no dedicated model for them.
+					localTarget := make(map[string]string)
+					if v, ok := targetMap["xPath"]; ok {
+						localTarget["xpath"] = v.(string)
+					}
+					if v, ok := targetMap["operator"]; ok {
+						localTarget["operator"] = v.(string)
+					}
+					if v, ok := targetMap["targetValue"]; ok {
+						localTarget["targetvalue"] = convertToString(v)
+					}
+					localAssertion["targetxpath"] = []map[string]string{localTarget}
+				} else {
+					localAssertion["target"] = convertToString(target)
+				}
 			}
 			if v, ok := assertionTarget.GetTypeOk(); ok {
 				localAssertion["type"] = string(*v)
@@ -1363,11 +1653,79 @@ func setFloatTargetValue(subTarget *datadogV1.SyntheticsAssertionJSONPathTargetT
 	}
 }
 
+// validateSyntheticsAssertions checks at plan time that each assertion block
+// combines its operator with the matching kind of target, so that invalid
+// combinations fail during the plan instead of during the apply.
+func validateSyntheticsAssertions(diff *schema.ResourceDiff, meta interface{}) error {
+	assertions, ok := diff.GetOk("assertion")
+	if !ok {
+		return nil
+	}
+	for i, assertion := range assertions.([]interface{}) {
+		assertionMap := assertion.(map[string]interface{})
+		operator, _ := assertionMap["operator"].(string)
+		target, _ := assertionMap["target"].(string)
+		targetJSONPath, _ := assertionMap["targetjsonpath"].([]interface{})
+		targetXPath, _ := assertionMap["targetxpath"].([]interface{})
+		switch operator {
+		case string(datadogV1.SYNTHETICSASSERTIONJSONPATHOPERATOR_VALIDATES_JSON_PATH):
+			if len(targetJSONPath) == 0 {
+				return fmt.Errorf("assertion.%d: operator %s requires a targetjsonpath block", i, operator)
+			}
+			if target != "" || len(targetXPath) > 0 {
+				return fmt.Errorf("assertion.%d: operator %s only accepts a targetjsonpath block", i, operator)
+			}
+		case syntheticsAssertionOperatorValidatesXPath:
+			if len(targetXPath) == 0 {
+				return fmt.Errorf("assertion.%d: operator %s requires a targetxpath block", i, operator)
+			}
+			if target != "" || len(targetJSONPath) > 0 {
+				return fmt.Errorf("assertion.%d: operator %s only accepts a targetxpath block", i, operator)
+			}
+		default:
+			if len(targetJSONPath) > 0 {
+				return fmt.Errorf("assertion.%d: targetjsonpath can only be used with the validatesJSONPath operator", i)
+			}
+			if len(targetXPath) > 0 {
+				return fmt.Errorf("assertion.%d: targetxpath can only be used with the validatesXPath operator", i)
+			}
+			if operator == string(datadogV1.SYNTHETICSASSERTIONOPERATOR_MATCHES) ||
+				operator == string(datadogV1.SYNTHETICSASSERTIONOPERATOR_DOES_NOT_MATCH) {
+				if err := validateSyntheticsRegexTarget(i, target); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+var syntheticsRegexFlagsRegexp = regexp.MustCompile(`^[gimsuy]*$`)
+
+// validateSyntheticsRegexTarget validates the target of a `matches` or
+// `doesNotMatch` assertion. Targets of the form `/pattern/flags` must carry a
+// closing slash and only JavaScript regex flags; bare patterns are accepted
+// as-is since the backend evaluates them with JavaScript regex semantics that
+// cannot be fully reproduced here.
+func validateSyntheticsRegexTarget(index int, target string) error {
+	if !strings.HasPrefix(target, "/") {
+		return nil
+	}
+	end := strings.LastIndex(target, "/")
+	if end == 0 {
+		return fmt.Errorf("assertion.%d: regex target %q is missing its closing slash", index, target)
+	}
+	if flags := target[end+1:]; !syntheticsRegexFlagsRegexp.MatchString(flags) {
+		return fmt.Errorf("assertion.%d: regex target %q has invalid flags %q", index, target, flags)
+	}
+	return nil
+}
+
 func validateSyntheticsAssertionOperator(val interface{}, key string) (warns []string, errs []error) {
 	_, err := datadogV1.NewSyntheticsAssertionOperatorFromValue(val.(string))
 	if err != nil {
 		_, err2 := datadogV1.NewSyntheticsAssertionJSONPathOperatorFromValue(val.(string))
-		if err2 != nil {
+		if err2 != nil && val.(string) != syntheticsAssertionOperatorValidatesXPath {
 			errs = append(errs, err, err2)
 		}
 	}
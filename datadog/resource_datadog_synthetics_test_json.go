@@ -0,0 +1,168 @@
+package datadog
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+// syntheticsTestJSONComputedFields are the fields the API populates on a test
+// export. They are stripped before diffing so an export pasted from the UI
+// doesn't generate perpetual diffs.
+var syntheticsTestJSONComputedFields = []string{
+	"created_at",
+	"created_by",
+	"creator",
+	"deleted_at",
+	"modified_at",
+	"modified_by",
+	"monitor_id",
+	"org_id",
+	"overall_state",
+	"overall_state_modified",
+	"public_id",
+	"stepCount",
+}
+
+func resourceDatadogSyntheticsTestJSON() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog synthetics test resource defined by a raw JSON export, as returned by the API or the UI export. This smooths migration of existing tests; for new tests prefer the `datadog_synthetics_test` resource.",
+		Create:      resourceDatadogSyntheticsTestJSONCreate,
+		Read:        resourceDatadogSyntheticsTestJSONRead,
+		Update:      resourceDatadogSyntheticsTestJSONUpdate,
+		Delete:      resourceDatadogSyntheticsTestJSONDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"test": {
+				Description:  "JSON export of the test. Computed fields like `public_id` and `monitor_id` are stripped before diffing, so the export can be pasted as is.",
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+				StateFunc: func(v interface{}) string {
+					return normalizeSyntheticsTestJSON(v.(string))
+				},
+			},
+			// Computed values
+			"monitor_id": {
+				Description: "ID of the monitor associated with the test.",
+				Type:        schema.TypeInt,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// normalizeSyntheticsTestJSON strips the computed fields from a test export
+// and re-marshals it with sorted keys, so that state comparisons are stable.
+func normalizeSyntheticsTestJSON(definition string) string {
+	var test map[string]interface{}
+	if err := json.Unmarshal([]byte(definition), &test); err != nil {
+		return definition
+	}
+	for _, field := range syntheticsTestJSONComputedFields {
+		delete(test, field)
+	}
+	normalized, err := json.Marshal(test)
+	if err != nil {
+		return definition
+	}
+	return string(normalized)
+}
+
+func buildSyntheticsTestJSONPayload(d *schema.ResourceData) (map[string]interface{}, error) {
+	var test map[string]interface{}
+	if err := json.Unmarshal([]byte(d.Get("test").(string)), &test); err != nil {
+		return nil, err
+	}
+	for _, field := range syntheticsTestJSONComputedFields {
+		delete(test, field)
+	}
+	return test, nil
+}
+
+func syntheticsTestJSONPath(d *schema.ResourceData, test map[string]interface{}) string {
+	if test["type"] == "browser" {
+		return "/api/v1/synthetics/tests/browser/" + d.Id()
+	}
+	return "/api/v1/synthetics/tests/" + d.Id()
+}
+
+func resourceDatadogSyntheticsTestJSONCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload, err := buildSyntheticsTestJSONPayload(d)
+	if err != nil {
+		return err
+	}
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodPost, "/api/v1/synthetics/tests", payload, &response); err != nil {
+		return translateClientError(err, "error creating synthetics test from JSON")
+	}
+	publicID, ok := response["public_id"].(string)
+	if !ok {
+		return fmt.Errorf("error creating synthetics test from JSON: no public_id in response")
+	}
+	d.SetId(publicID)
+
+	return resourceDatadogSyntheticsTestJSONRead(d, meta)
+}
+
+func resourceDatadogSyntheticsTestJSONRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var test map[string]interface{}
+	httpresp, err := sendRequest(providerConf, http.MethodGet, "/api/v1/synthetics/tests/"+d.Id(), nil, &test)
+	if err != nil {
+		if httpresp != nil && httpresp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return translateClientError(err, "error getting synthetics test")
+	}
+	// re-fetch the test if it was actually a browser test
+	if test["type"] == "browser" {
+		if _, err := sendRequest(providerConf, http.MethodGet, "/api/v1/synthetics/tests/browser/"+d.Id(), nil, &test); err != nil {
+			return translateClientError(err, "error getting synthetics test")
+		}
+	}
+
+	if monitorID, ok := test["monitor_id"].(float64); ok {
+		d.Set("monitor_id", int(monitorID))
+	}
+	normalized, err := json.Marshal(test)
+	if err != nil {
+		return err
+	}
+	return d.Set("test", normalizeSyntheticsTestJSON(string(normalized)))
+}
+
+func resourceDatadogSyntheticsTestJSONUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload, err := buildSyntheticsTestJSONPayload(d)
+	if err != nil {
+		return err
+	}
+	if _, err := sendRequest(providerConf, http.MethodPut, syntheticsTestJSONPath(d, payload), payload, nil); err != nil {
+		return translateClientError(err, "error updating synthetics test from JSON")
+	}
+
+	return resourceDatadogSyntheticsTestJSONRead(d, meta)
+}
+
+func resourceDatadogSyntheticsTestJSONDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	payload := map[string]interface{}{"public_ids": []string{d.Id()}}
+	if _, err := sendRequest(providerConf, http.MethodPost, "/api/v1/synthetics/tests/delete", payload, nil); err != nil {
+		return translateClientError(err, "error deleting synthetics test")
+	}
+
+	return nil
+}
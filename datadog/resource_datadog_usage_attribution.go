@@ -0,0 +1,114 @@
+package datadog
+
+import (
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+// usageAttributionPath is the usage attribution configuration endpoint,
+// which is missing from the pinned API client.
+const usageAttributionPath = "/api/v2/usage/attribution_config"
+
+func resourceDatadogUsageAttribution() *schema.Resource {
+	return &schema.Resource{
+		Description: "Provides a Datadog usage attribution configuration resource, selecting the tag keys usage is broken down by. The configuration is org wide, so only one instance of this resource is needed; managing it here keeps chargeback dimension changes coordinated and audited.",
+		Create:      resourceDatadogUsageAttributionCreate,
+		Read:        resourceDatadogUsageAttributionRead,
+		Update:      resourceDatadogUsageAttributionUpdate,
+		Delete:      resourceDatadogUsageAttributionDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"tag_keys": {
+				Description: "The tag keys usage is attributed to, at most 3.",
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    3,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func updateUsageAttribution(providerConf *ProviderConfiguration, tagKeys []string) error {
+	payload := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "usage_attribution_config",
+			"attributes": map[string]interface{}{
+				"tag_keys": tagKeys,
+			},
+		},
+	}
+	if _, err := sendRequest(providerConf, http.MethodPut, usageAttributionPath, payload, nil); err != nil {
+		return translateClientError(err, "error updating usage attribution configuration")
+	}
+	return nil
+}
+
+func buildUsageAttributionTagKeys(d *schema.ResourceData) []string {
+	tagKeys := []string{}
+	for _, key := range d.Get("tag_keys").([]interface{}) {
+		tagKeys = append(tagKeys, key.(string))
+	}
+	return tagKeys
+}
+
+func resourceDatadogUsageAttributionCreate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if err := updateUsageAttribution(providerConf, buildUsageAttributionTagKeys(d)); err != nil {
+		return err
+	}
+	// The configuration is an org wide singleton.
+	d.SetId("usage-attribution")
+
+	return resourceDatadogUsageAttributionRead(d, meta)
+}
+
+func resourceDatadogUsageAttributionRead(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	var response map[string]interface{}
+	if _, err := sendRequest(providerConf, http.MethodGet, usageAttributionPath, nil, &response); err != nil {
+		return translateClientError(err, "error getting usage attribution configuration")
+	}
+	data, _ := response["data"].(map[string]interface{})
+	attributes, _ := data["attributes"].(map[string]interface{})
+	tagKeys := []string{}
+	if raw, ok := attributes["tag_keys"].([]interface{}); ok {
+		for _, key := range raw {
+			if tagKey, ok := key.(string); ok {
+				tagKeys = append(tagKeys, tagKey)
+			}
+		}
+	}
+	if len(tagKeys) == 0 {
+		d.SetId("")
+		return nil
+	}
+	d.Set("tag_keys", tagKeys)
+
+	return nil
+}
+
+func resourceDatadogUsageAttributionUpdate(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	if err := updateUsageAttribution(providerConf, buildUsageAttributionTagKeys(d)); err != nil {
+		return err
+	}
+
+	return resourceDatadogUsageAttributionRead(d, meta)
+}
+
+func resourceDatadogUsageAttributionDelete(d *schema.ResourceData, meta interface{}) error {
+	providerConf := meta.(*ProviderConfiguration)
+
+	// The API has no delete call for the configuration; clearing the tag keys
+	// stops the attribution.
+	return updateUsageAttribution(providerConf, []string{})
+}
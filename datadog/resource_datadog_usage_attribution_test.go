@@ -0,0 +1,18 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestBuildUsageAttributionTagKeys(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceDatadogUsageAttribution().Schema, map[string]interface{}{
+		"tag_keys": []interface{}{"team", "service"},
+	})
+
+	if tagKeys := buildUsageAttributionTagKeys(d); !reflect.DeepEqual(tagKeys, []string{"team", "service"}) {
+		t.Errorf("unexpected tag keys: %v", tagKeys)
+	}
+}
@@ -0,0 +1,207 @@
+package datadog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	datadogV1 "github.com/DataDog/datadog-api-client-go/api/v1/datadog"
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// Sweepers delete resources whose name starts with the sweep prefix, so CI
+// orgs don't accumulate junk from interrupted acceptance test runs and botched
+// imports can be cleaned up by hand. Run them with
+//
+//	go test ./datadog -sweep=all [-sweep-run=datadog_dashboard] [-sweep-allow-failures]
+//
+// against the org selected by the usual DATADOG_API_KEY/DATADOG_APP_KEY
+// environment variables. The prefix defaults to "tf-test" and can be
+// overridden with SWEEP_PREFIX.
+
+func init() {
+	resource.AddTestSweepers("datadog_dashboard", &resource.Sweeper{
+		Name: "datadog_dashboard",
+		F:    sweepDashboards,
+	})
+	resource.AddTestSweepers("datadog_monitor", &resource.Sweeper{
+		Name: "datadog_monitor",
+		F:    sweepMonitors,
+	})
+	resource.AddTestSweepers("datadog_synthetics_test", &resource.Sweeper{
+		Name: "datadog_synthetics_test",
+		F:    sweepSyntheticsTests,
+	})
+	resource.AddTestSweepers("datadog_service_level_objective", &resource.Sweeper{
+		Name: "datadog_service_level_objective",
+		F:    sweepServiceLevelObjectives,
+	})
+}
+
+// sweepPrefix returns the name prefix a resource must have to be swept.
+func sweepPrefix() string {
+	if prefix := os.Getenv("SWEEP_PREFIX"); prefix != "" {
+		return prefix
+	}
+	return "tf-test"
+}
+
+// sweeperConfiguration builds a ProviderConfiguration from the environment,
+// outside of the recorder-based test harness.
+func sweeperConfiguration() (*ProviderConfiguration, error) {
+	apiKey, _ := lookupEnvVars("DATADOG_API_KEY", "DD_API_KEY")
+	appKey, _ := lookupEnvVars("DATADOG_APP_KEY", "DD_APP_KEY")
+	apiURL, _ := lookupEnvVars("DATADOG_HOST", "DD_HOST")
+	if apiKey == "" || appKey == "" {
+		return nil, errors.New("DATADOG_API_KEY and DATADOG_APP_KEY must be set to run sweepers")
+	}
+
+	authV1 := context.WithValue(
+		context.Background(),
+		datadogV1.ContextAPIKeys,
+		map[string]datadogV1.APIKey{
+			"apiKeyAuth": {
+				Key: apiKey,
+			},
+			"appKeyAuth": {
+				Key: appKey,
+			},
+		},
+	)
+	configV1 := datadogV1.NewConfiguration()
+	if apiURL != "" {
+		parsedAPIURL, parseErr := url.Parse(apiURL)
+		if parseErr != nil {
+			return nil, fmt.Errorf(`invalid API Url : %v`, parseErr)
+		}
+		authV1 = context.WithValue(authV1, datadogV1.ContextServerIndex, 1)
+		authV1 = context.WithValue(authV1, datadogV1.ContextServerVariables, map[string]string{
+			"name":     parsedAPIURL.Host,
+			"protocol": parsedAPIURL.Scheme,
+		})
+	}
+
+	return &ProviderConfiguration{
+		DatadogClientV1: datadogV1.NewAPIClient(configV1),
+		AuthV1:          authV1,
+
+		apiKey:     apiKey,
+		appKey:     appKey,
+		apiURL:     apiURL,
+		httpClient: cleanhttp.DefaultClient(),
+
+		now: time.Now,
+	}, nil
+}
+
+func lookupEnvVars(names ...string) (string, bool) {
+	for _, name := range names {
+		if value, ok := os.LookupEnv(name); ok {
+			return value, true
+		}
+	}
+	return "", false
+}
+
+func sweepDashboards(region string) error {
+	providerConf, err := sweeperConfiguration()
+	if err != nil {
+		return err
+	}
+	dashboards, _, err := providerConf.DatadogClientV1.DashboardsApi.ListDashboards(providerConf.AuthV1).Execute()
+	if err != nil {
+		return fmt.Errorf("error listing dashboards: %v", err)
+	}
+	for _, dashboard := range dashboards.GetDashboards() {
+		if !strings.HasPrefix(dashboard.GetTitle(), sweepPrefix()) {
+			continue
+		}
+		log.Printf("[INFO] Sweeping dashboard %s (%s)", dashboard.GetId(), dashboard.GetTitle())
+		if _, _, err := providerConf.DatadogClientV1.DashboardsApi.DeleteDashboard(providerConf.AuthV1, dashboard.GetId()).Execute(); err != nil {
+			return fmt.Errorf("error deleting dashboard %s: %v", dashboard.GetId(), err)
+		}
+	}
+	return nil
+}
+
+func sweepMonitors(region string) error {
+	providerConf, err := sweeperConfiguration()
+	if err != nil {
+		return err
+	}
+	monitors, _, err := providerConf.DatadogClientV1.MonitorsApi.ListMonitors(providerConf.AuthV1).Execute()
+	if err != nil {
+		return fmt.Errorf("error listing monitors: %v", err)
+	}
+	for _, monitor := range monitors {
+		if !strings.HasPrefix(monitor.GetName(), sweepPrefix()) {
+			continue
+		}
+		log.Printf("[INFO] Sweeping monitor %d (%s)", monitor.GetId(), monitor.GetName())
+		if _, _, err := providerConf.DatadogClientV1.MonitorsApi.DeleteMonitor(providerConf.AuthV1, monitor.GetId()).Execute(); err != nil {
+			return fmt.Errorf("error deleting monitor %d: %v", monitor.GetId(), err)
+		}
+	}
+	return nil
+}
+
+func sweepSyntheticsTests(region string) error {
+	providerConf, err := sweeperConfiguration()
+	if err != nil {
+		return err
+	}
+	tests, _, err := providerConf.DatadogClientV1.SyntheticsApi.ListTests(providerConf.AuthV1).Execute()
+	if err != nil {
+		return fmt.Errorf("error listing synthetics tests: %v", err)
+	}
+	publicIds := []string{}
+	for _, test := range tests.GetTests() {
+		if !strings.HasPrefix(test.GetName(), sweepPrefix()) {
+			continue
+		}
+		log.Printf("[INFO] Sweeping synthetics test %s (%s)", test.GetPublicId(), test.GetName())
+		publicIds = append(publicIds, test.GetPublicId())
+	}
+	if len(publicIds) == 0 {
+		return nil
+	}
+	deleteBody := datadogV1.NewSyntheticsDeleteTestsPayload()
+	deleteBody.SetPublicIds(publicIds)
+	if _, _, err := providerConf.DatadogClientV1.SyntheticsApi.DeleteTests(providerConf.AuthV1).Body(*deleteBody).Execute(); err != nil {
+		return fmt.Errorf("error deleting synthetics tests: %v", err)
+	}
+	return nil
+}
+
+func sweepServiceLevelObjectives(region string) error {
+	providerConf, err := sweeperConfiguration()
+	if err != nil {
+		return err
+	}
+	// ListSLOs requires explicit ids, list through a direct request instead
+	var slos struct {
+		Data []struct {
+			Id   string `json:"id"`
+			Name string `json:"name"`
+		} `json:"data"`
+	}
+	if _, err := sendRequest(providerConf, "GET", "/api/v1/slo?limit=1000", nil, &slos); err != nil {
+		return fmt.Errorf("error listing service level objectives: %v", err)
+	}
+	for _, slo := range slos.Data {
+		if !strings.HasPrefix(slo.Name, sweepPrefix()) {
+			continue
+		}
+		log.Printf("[INFO] Sweeping service level objective %s (%s)", slo.Id, slo.Name)
+		if _, _, err := providerConf.DatadogClientV1.ServiceLevelObjectivesApi.DeleteSLO(providerConf.AuthV1, slo.Id).Execute(); err != nil {
+			return fmt.Errorf("error deleting service level objective %s: %v", slo.Id, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package datadog
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// apiCallTelemetry counts the Datadog API calls made through the shared HTTP
+// transport, keyed by method and normalized route (path segments that look
+// like resource IDs are collapsed to `{id}`). Routes map one to one to
+// resource types in practice, so the summary helps users diagnose rate-limit
+// exhaustion and identify pathological resources.
+type apiCallTelemetry struct {
+	mu     sync.Mutex
+	counts map[string]int
+}
+
+func newAPICallTelemetry() *apiCallTelemetry {
+	return &apiCallTelemetry{counts: map[string]int{}}
+}
+
+// apiCallVersionRegexp matches API version segments (`v1`, `v2`), which are
+// part of the route even though they contain a digit.
+var apiCallVersionRegexp = regexp.MustCompile(`^v[0-9]+$`)
+
+// apiCallIDRegexp matches path segments that are resource IDs rather than part
+// of the route: numbers, tokens with uppercase characters or digits, long hex
+// tokens and dashboard-style three-part dash tokens (`abc-def-ghi`).
+var apiCallIDRegexp = regexp.MustCompile(`^([0-9]+|.*[A-Z0-9].*|[0-9a-f-]{20,}|[0-9a-z]{3}-[0-9a-z]{3}-[0-9a-z]{3})$`)
+
+func (t *apiCallTelemetry) countCall(method, path string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for i, segment := range segments {
+		if apiCallVersionRegexp.MatchString(segment) {
+			continue
+		}
+		if apiCallIDRegexp.MatchString(segment) {
+			segments[i] = "{id}"
+		}
+	}
+	key := method + " /" + strings.Join(segments, "/")
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.counts[key]++
+}
+
+// logSummary logs the API call counts collected so far, most called routes
+// first.
+func (t *apiCallTelemetry) logSummary() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.counts) == 0 {
+		return
+	}
+	keys := make([]string, 0, len(t.counts))
+	total := 0
+	for key, count := range t.counts {
+		keys = append(keys, key)
+		total += count
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if t.counts[keys[i]] != t.counts[keys[j]] {
+			return t.counts[keys[i]] > t.counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+	var summary strings.Builder
+	for _, key := range keys {
+		fmt.Fprintf(&summary, "\n%6d %s", t.counts[key], key)
+	}
+	log.Printf("[INFO] Datadog API call summary, %d calls total:%s", total, summary.String())
+}
@@ -0,0 +1,34 @@
+package datadog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCountCall(t *testing.T) {
+	telemetry := newAPICallTelemetry()
+	telemetry.countCall("GET", "/api/v1/dashboard/abc-def-ghi")
+	telemetry.countCall("GET", "/api/v1/dashboard/jkl-mn0-pqr")
+	telemetry.countCall("GET", "/api/v2/dashboard")
+	telemetry.countCall("GET", "/api/v1/monitor/123456")
+	telemetry.countCall("GET", "/api/v2/monitor/123456")
+	telemetry.countCall("POST", "/api/v1/monitor")
+	telemetry.countCall("GET", "/api/v2/users/d4874ae4-3a3b-4c51-a219-21a2ca3f1a58")
+	telemetry.countCall("GET", "/api/v1/integration/aws/event_bridge")
+
+	// Version segments stay part of the route, so v1 and v2 endpoints with
+	// the same tail are attributed separately, while numeric, dashboard-style
+	// and UUID IDs are collapsed to `{id}`.
+	expected := map[string]int{
+		"GET /api/v1/dashboard/{id}":               2,
+		"GET /api/v2/dashboard":                    1,
+		"GET /api/v1/monitor/{id}":                 1,
+		"GET /api/v2/monitor/{id}":                 1,
+		"POST /api/v1/monitor":                     1,
+		"GET /api/v2/users/{id}":                   1,
+		"GET /api/v1/integration/aws/event_bridge": 1,
+	}
+	if !reflect.DeepEqual(telemetry.counts, expected) {
+		t.Errorf("unexpected counts: %v", telemetry.counts)
+	}
+}
@@ -2,7 +2,11 @@ package datadog
 
 import (
 	"fmt"
+	"net/url"
 	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 )
@@ -24,6 +28,162 @@ func validateAggregatorMethod(v interface{}, k string) (ws []string, errors []er
 	return
 }
 
+// validateHexColor checks that a value is a 3 or 6 digit hex color such as
+// `#fff` or `#ff0000`.
+func validateHexColor(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !hexColorRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a 3 or 6 digit hex color such as `#fff` or `#ff0000`, got %q", k, value))
+	}
+	return
+}
+
+var hexColorRegexp = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// validateWidgetAxisValue checks that an axis bound is a number, `auto` or
+// empty, so typos fail at plan rather than being silently ignored by the UI.
+func validateWidgetAxisValue(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "" || value == "auto" {
+		return
+	}
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a number, `auto` or empty, got %q", k, value))
+	}
+	return
+}
+
+// validateMarkerValue checks that a widget marker value is a mathematical
+// expression on `y` such as `y > 1`, `-5 < y < 0` or `y = 19`, since invalid
+// markers are only surfaced by the UI renderer.
+func validateMarkerValue(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !markerValueRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a mathematical expression such as `y > 1`, `-5 < y < 0` or `y = 19`, got %q", k, value))
+	}
+	return
+}
+
+var markerValueRegexp = regexp.MustCompile(
+	`^(y *(=|>|>=|<|<=) *` + markerNumberPattern +
+		`|` + markerNumberPattern + ` *(<|<=) *y *(<|<=) *` + markerNumberPattern +
+		`|` + markerNumberPattern + ` *(>|>=) *y *(>|>=) *` + markerNumberPattern + `)$`)
+
+const markerNumberPattern = `-?[0-9]+(\.[0-9]+)?([eE][+-]?[0-9]+)?`
+
+// validateMarkerDisplayType checks that a widget marker display type is one of
+// the severities `error`, `warning`, `info` or `ok`, optionally combined with
+// one of the line styles `dashed`, `solid` or `bold`, eg. `error dashed`.
+func validateMarkerDisplayType(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if !markerDisplayTypeRegexp.MatchString(value) {
+		errors = append(errors, fmt.Errorf(
+			"%q must be one of `error`, `warning`, `info` or `ok`, optionally combined with one of `dashed`, `solid` or `bold` such as `error dashed`, got %q", k, value))
+	}
+	return
+}
+
+var markerDisplayTypeRegexp = regexp.MustCompile(`^(error|warning|info|ok)( (dashed|solid|bold))?$`)
+
+// validatePrimaryTag checks that a primary tag is either a `key:value` pair
+// such as `datacenter:us1` or the wildcard `*`.
+func validatePrimaryTag(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if value == "*" {
+		return
+	}
+	if parts := strings.SplitN(value, ":", 2); len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a `key:value` pair such as `datacenter:us1` or `*`, got %q", k, value))
+	}
+	return
+}
+
+// validateWidgetURL checks that a widget URL is an absolute http(s) URL.
+// URLs containing template variable interpolation markers (eg. `$var.value`)
+// are accepted as-is since they are resolved by the frontend and must not be
+// escaped by the provider.
+func validateWidgetURL(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if strings.Contains(value, "$") {
+		return
+	}
+	u, err := url.Parse(value)
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a valid http(s) URL or contain a template variable marker such as `$var.value`, got %q", k, value))
+	}
+	return
+}
+
+// widgetPalettes are the palette names accepted by widget styles. The fill
+// palettes at the end are specific to the hostmap, which shares the field.
+var widgetPalettes = []string{
+	"blue", "cool", "dog_classic", "gray", "grey", "green", "orange", "purple", "red", "semantic", "warm",
+	"green_to_orange", "yellow_to_green", "YlOrRd", "hostmap_blues",
+}
+
+// validateWidgetPalette checks style palette names, since a typo is otherwise
+// only visible as the widget rendering in default colors. Likely typos get a
+// "did you mean" suggestion.
+func validateWidgetPalette(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	for _, palette := range widgetPalettes {
+		if value == palette {
+			return
+		}
+	}
+	msg := fmt.Sprintf("%q contains an invalid palette %q", k, value)
+	if suggestion := closestString(value, widgetPalettes); suggestion != "" {
+		msg += fmt.Sprintf(", did you mean %q?", suggestion)
+	}
+	errors = append(errors, fmt.Errorf("%s. Valid palettes are `%s`", msg, strings.Join(widgetPalettes, "`, `")))
+	return
+}
+
+// closestString returns the candidate with the smallest edit distance to
+// value, or an empty string when nothing is within 3 edits.
+func closestString(value string, candidates []string) string {
+	best, bestDistance := "", 4
+	for _, candidate := range candidates {
+		if d := editDistance(strings.ToLower(value), strings.ToLower(candidate)); d < bestDistance {
+			best, bestDistance = candidate, d
+		}
+	}
+	return best
+}
+
+func editDistance(a, b string) int {
+	distances := make([]int, len(b)+1)
+	for j := range distances {
+		distances[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		previous := distances[0]
+		distances[0] = i
+		for j := 1; j <= len(b); j++ {
+			current := distances[j]
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			distances[j] = minInt(distances[j]+1, minInt(distances[j-1]+1, previous+cost))
+			previous = current
+		}
+	}
+	return distances[len(b)]
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
 // validateEnumValue returns a validate func for an enum value. It takes the constructor with validation for the enum as an argument.
 // Such a constructor is for instance `datadogV1.NewWidgetLineWidthFromValue`
 func validateEnumValue(newEnumFunc interface{}) schema.SchemaValidateFunc {